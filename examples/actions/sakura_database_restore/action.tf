@@ -0,0 +1,9 @@
+action "sakura_database_restore" "foobar" {
+  config {
+    database_id       = "123456789012" # e.g. sakura_database.foobar.id
+    backup_created_at = "2026-10-18T00:00:00+09:00"
+  }
+}
+
+# Run the action with the CLI
+# $ terraform apply -invoke=action.sakura_database_restore.foobar
@@ -0,0 +1,22 @@
+action "sakura_webaccel_purge_cache" "foobar" {
+  config {
+    site_id = "webaccel-site-id" # e.g. sakura_webaccel.foobar.id
+    # Purge the specified URLs only. Omit urls to purge the whole site.
+    urls = [
+      "https://www.example.com/index.html",
+      "https://www.example.com/assets/app.js",
+    ]
+  }
+}
+
+# Purge the cache after the content is deployed
+resource "terraform_data" "deploy" {
+  input = "content-version"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.sakura_webaccel_purge_cache.foobar]
+    }
+  }
+}
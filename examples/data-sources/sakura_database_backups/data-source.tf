@@ -0,0 +1,7 @@
+data "sakura_database_backups" "foobar" {
+  database_id = "123456789012"
+}
+
+output "latest_backup" {
+  value = try(data.sakura_database_backups.foobar.backups[0].created_at, null)
+}
@@ -0,0 +1,9 @@
+data "sakura_dns_zone_file" "foobar" {
+  dns_id = "123456789012"
+}
+
+# Export the zone file as a backup
+resource "local_file" "backup" {
+  filename = "${path.module}/backup.zone"
+  content  = data.sakura_dns_zone_file.foobar.content
+}
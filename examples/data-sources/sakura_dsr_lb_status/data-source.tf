@@ -0,0 +1,11 @@
+data "sakura_dsr_lb_status" "foobar" {
+  dsr_lb_id = sakura_dsr_lb.foobar.id
+  zone      = sakura_dsr_lb.foobar.zone
+}
+
+check "dsr_lb_status" {
+  assert {
+    condition     = data.sakura_dsr_lb_status.foobar.healthy
+    error_message = "some real servers of the DSR LB are not up"
+  }
+}
@@ -0,0 +1,10 @@
+data "sakura_enhanced_lb_health" "foobar" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+}
+
+check "enhanced_lb_health" {
+  assert {
+    condition     = data.sakura_enhanced_lb_health.foobar.healthy
+    error_message = "some servers of the Enhanced LB are not up"
+  }
+}
@@ -0,0 +1,9 @@
+data "sakura_iam_effective_permissions" "foobar" {
+  principal = {
+    id   = "user-id"
+    type = "user"
+  }
+  target         = "project"
+  target_id      = "project-id"
+  include_groups = true
+}
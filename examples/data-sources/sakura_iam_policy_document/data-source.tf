@@ -0,0 +1,31 @@
+data "sakura_iam_policy_document" "foobar" {
+  target = "project"
+  binding = [
+    {
+      role = "owner"
+      principals = [{
+        id   = "service-principal-id"
+        type = "service-principal"
+      }]
+    },
+    {
+      role = "viewer"
+      principals = [
+        {
+          id   = "service-principal-id"
+          type = "service-principal"
+        },
+        {
+          id   = "user-id"
+          type = "user"
+        },
+      ]
+    },
+  ]
+}
+
+resource "sakura_iam_policy" "foobar" {
+  target    = "project"
+  target_id = "project-id"
+  bindings  = data.sakura_iam_policy_document.foobar.bindings
+}
@@ -0,0 +1,7 @@
+data "sakura_webaccel" "site" {
+  name = "foobar"
+}
+
+data "sakura_webaccel_access_logs" "foobar" {
+  site_id = data.sakura_webaccel.site.id
+}
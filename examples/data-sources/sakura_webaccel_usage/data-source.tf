@@ -0,0 +1,8 @@
+data "sakura_webaccel_usage" "foobar" {
+  target_month = "202601" # yyyymm, defaults to the current month
+  # site_id    = "123456789012"
+}
+
+output "webaccel_price_by_site" {
+  value = { for s in data.sakura_webaccel_usage.foobar.sites : s.domain => s.price }
+}
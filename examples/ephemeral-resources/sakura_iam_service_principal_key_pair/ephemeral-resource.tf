@@ -0,0 +1,3 @@
+ephemeral "sakura_iam_service_principal_key_pair" "foobar" {
+  rsa_bits = 2048 # or 3072, 4096
+}
@@ -0,0 +1,5 @@
+ephemeral "sakura_kms_data_key" "foobar" {
+  kms_id = sakura_kms.foobar.id
+  // specify the stored encrypted data key to decrypt it instead of generating a new one
+  //ciphertext = var.encrypted_data_key
+}
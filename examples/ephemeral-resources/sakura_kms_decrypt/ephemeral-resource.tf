@@ -0,0 +1,11 @@
+ephemeral "sakura_kms_decrypt" "foobar" {
+  kms_id     = sakura_kms.foobar.id
+  ciphertext = sakura_kms_ciphertext.foobar.ciphertext
+}
+
+resource "sakura_secret_manager_secret" "foobar" {
+  name             = "foobar"
+  vault_id         = sakura_secret_manager.foobar.id
+  value_wo         = ephemeral.sakura_kms_decrypt.foobar.plaintext
+  value_wo_version = 1
+}
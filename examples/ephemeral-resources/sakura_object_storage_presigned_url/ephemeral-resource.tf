@@ -0,0 +1,18 @@
+data "sakura_object_storage_site" "foobar" {
+  id = "isk01"
+}
+
+ephemeral "sakura_object_storage_presigned_url" "foobar" {
+  region             = data.sakura_object_storage_site.foobar.region
+  endpoint           = data.sakura_object_storage_site.foobar.s3_endpoint
+  access_key         = var.access_key
+  secret_key         = var.secret_key
+  bucket             = "foobar"
+  key                = "artifacts/app.tar.gz"
+  method             = "GET" # or "PUT"
+  expiration_seconds = 900
+
+  # Optional: override response headers (GET only)
+  response_content_type        = "application/gzip"
+  response_content_disposition = "attachment; filename=\"app.tar.gz\""
+}
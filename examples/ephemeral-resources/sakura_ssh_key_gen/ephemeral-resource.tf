@@ -0,0 +1,22 @@
+ephemeral "sakura_ssh_key_gen" "foobar" {
+  key_type = "ed25519"
+  // for RSA key
+  //key_type = "rsa"
+  //rsa_bits = 4096
+}
+
+resource "sakura_ssh_key" "foobar" {
+  name                  = "foobar"
+  public_key_wo         = ephemeral.sakura_ssh_key_gen.foobar.public_key
+  public_key_wo_version = 1
+}
+
+resource "sakura_secret_manager_secret" "foobar" {
+  name             = "foobar-ssh-private-key"
+  vault_id         = "secret_manager-resource-id" # e.g. sakura_secret_manager.foobar.id
+  value_wo         = ephemeral.sakura_ssh_key_gen.foobar.private_key_openssh
+  value_wo_version = 1
+}
+
+// the registered key can be used in sakura_server.disk_edit_parameter.ssh_key_ids in the same apply
+//ssh_key_ids = [sakura_ssh_key.foobar.id]
@@ -0,0 +1,12 @@
+# provider::sakura::object_storage_presign(access_key, secret_key, endpoint, region, bucket, key, method, expiration_seconds, signing_time)
+resource "time_static" "signing" {}
+
+output "download_url" {
+  value     = provider::sakura::object_storage_presign(var.access_key, var.secret_key, null, null, "foobar", "artifacts/app.tar.gz", "GET", 900, time_static.signing.rfc3339)
+  sensitive = true
+}
+
+output "upload_url" {
+  value     = provider::sakura::object_storage_presign(var.access_key, var.secret_key, "s3.isk01.sakurastorage.jp", "jp-north-1", "foobar", "uploads/report.csv", "PUT", 300, time_static.signing.rfc3339)
+  sensitive = true
+}
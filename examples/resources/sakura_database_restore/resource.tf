@@ -0,0 +1,13 @@
+data "sakura_database_backups" "foobar" {
+  database_id = sakura_database.foobar.id
+}
+
+resource "sakura_database_restore" "foobar" {
+  database_id       = sakura_database.foobar.id
+  backup_created_at = data.sakura_database_backups.foobar.backups[0].created_at
+
+  # Change the value to restore the same backup again
+  triggers = {
+    restore = "1"
+  }
+}
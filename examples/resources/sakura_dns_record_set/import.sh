@@ -0,0 +1,2 @@
+# Import format: dns_id/name/type
+terraform import sakura_dns_record_set.www 123456789012/www/A
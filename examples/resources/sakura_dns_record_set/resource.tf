@@ -0,0 +1,18 @@
+resource "sakura_dns" "foobar" {
+  zone = "example.com"
+}
+
+resource "sakura_dns_record_set" "www" {
+  dns_id = sakura_dns.foobar.id
+  name   = "www"
+  type   = "A"
+  values = ["192.168.0.1", "192.168.0.2"]
+  ttl    = 300
+}
+
+resource "sakura_dns_record_set" "mx" {
+  dns_id = sakura_dns.foobar.id
+  name   = "@"
+  type   = "MX"
+  values = ["10 mail1.example.com.", "20 mail2.example.com."]
+}
@@ -0,0 +1,8 @@
+resource "sakura_dns" "foobar" {
+  zone = "example.com"
+}
+
+resource "sakura_dns_zone_file" "foobar" {
+  dns_id  = sakura_dns.foobar.id
+  content = file("${path.module}/example.com.zone")
+}
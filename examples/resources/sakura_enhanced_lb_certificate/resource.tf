@@ -0,0 +1,42 @@
+resource "sakura_enhanced_lb_certificate" "foobar" {
+  enhanced_lb_id    = data.sakura_enhanced_lb.foobar.id
+  server_cert       = file("server.crt")
+  intermediate_cert = file("intermediate.crt")
+  private_key       = file("server.key")
+
+  additional_certificate = [{
+    server_cert = file("server2.crt")
+    private_key = file("server2.key")
+  }]
+}
+
+data "sakura_enhanced_lb" "foobar" {
+  name = "foobar"
+}
+
+# The PEM bundle (server certificate, intermediate certificates and private key)
+# stored in Secret Manager, e.g. issued by an ACME client with DNS-01 challenges.
+# The certificate is set again when a new version of the secret is created.
+resource "sakura_enhanced_lb_certificate" "from_secret" {
+  enhanced_lb_id = data.sakura_enhanced_lb.other.id
+
+  source = {
+    secret_manager = {
+      vault_id = sakura_secret_manager.foobar.id
+      name     = "elb-certificate"
+    }
+  }
+}
+
+# The PEM bundle stored in Object Storage. The ETag of the object is used as its version.
+#
+# source = {
+#   object_storage = {
+#     bucket = "certificates"
+#     key    = "example.com/bundle.pem"
+#   }
+# }
+
+data "sakura_enhanced_lb" "other" {
+  name = "other"
+}
@@ -0,0 +1,21 @@
+resource "sakura_enhanced_lb_rule" "api" {
+  enhanced_lb_id = data.sakura_enhanced_lb.foobar.id
+  host           = "www.example.com"
+  path           = "/api"
+  group          = "api"
+}
+
+resource "sakura_enhanced_lb_rule" "maintenance" {
+  enhanced_lb_id     = data.sakura_enhanced_lb.foobar.id
+  host               = "maintenance.example.com"
+  action             = "fixed"
+  fixed_status_code  = "503"
+  fixed_content_type = "text/plain"
+  fixed_message_body = "under maintenance"
+
+  depends_on = [sakura_enhanced_lb_rule.api]
+}
+
+data "sakura_enhanced_lb" "foobar" {
+  name = "foobar"
+}
@@ -0,0 +1,12 @@
+resource "sakura_enhanced_lb_server" "foobar" {
+  enhanced_lb_id = data.sakura_enhanced_lb.foobar.id
+  ip_address     = "192.0.2.11"
+  port           = 80
+  group          = "group1"
+  enabled        = true
+  tls_enabled    = false
+}
+
+data "sakura_enhanced_lb" "foobar" {
+  name = "foobar"
+}
@@ -0,0 +1,2 @@
+# Specify the ID in the format of {target}[/{target_id}]/{role_type}:{role_id}: e.g. "project/123456789012/preset:viewer", "organization/preset:owner"
+terraform import sakura_iam_policy_binding.foo '{target}/{target_id}/{role_type}:{role_id}'
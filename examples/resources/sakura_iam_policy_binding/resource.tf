@@ -0,0 +1,12 @@
+resource "sakura_iam_policy_binding" "foobar" {
+  target    = "project" // "folder" or "organization" is also available
+  target_id = "project-id" # e.g. sakura_iam_project.foobar.id
+  role = {
+    id   = "viewer"
+    type = "preset"
+  }
+  principals = [{
+    id   = "service-principal-id" # e.g. sakura_iam_service_principal.foobar.id
+    type = "service-principal"
+  }]
+}
@@ -0,0 +1,2 @@
+# Specify the ID in the format of {target}[/{target_id}]/{role_type}:{role_id}/{principal_type}/{principal_id}: e.g. "project/123456789012/preset:owner/service-principal/123456789012"
+terraform import sakura_iam_policy_member.foo '{target}/{target_id}/{role_type}:{role_id}/{principal_type}/{principal_id}'
@@ -0,0 +1,32 @@
+resource "sakura_iam_service_principal" "foobar" {
+  name        = "foobar"
+  description = "description"
+  project_id  = "project-id" # e.g. sakura_iam_project.foobar.id
+}
+
+# The key pair is generated locally and the private key is never stored in the state
+ephemeral "sakura_iam_service_principal_key_pair" "foobar" {}
+
+resource "sakura_iam_service_principal_key" "foobar" {
+  service_principal_id  = sakura_iam_service_principal.foobar.id
+  public_key_wo         = ephemeral.sakura_iam_service_principal_key_pair.foobar.public_key_pem
+  public_key_wo_version = 1 # increment this to rotate the key
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+# Store the private key with the same version as the public key
+resource "sakura_secret_manager_secret" "foobar" {
+  name             = "foobar-private-key"
+  vault_id         = "secret_manager-resource-id" # e.g. sakura_secret_manager.foobar.id
+  value_wo         = ephemeral.sakura_iam_service_principal_key_pair.foobar.private_key_pem
+  value_wo_version = 1
+}
+
+# Or register an existing public key
+# resource "sakura_iam_service_principal_key" "foobar" {
+#   service_principal_id = sakura_iam_service_principal.foobar.id
+#   public_key           = file("public_key.pem")
+# }
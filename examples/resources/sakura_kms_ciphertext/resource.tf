@@ -0,0 +1,13 @@
+resource "sakura_kms_ciphertext" "foobar" {
+  kms_id               = sakura_kms.foobar.id
+  plaintext_wo         = var.secret
+  plaintext_wo_version = 1
+}
+
+// the plaintext is encrypted locally with a generated data key, and only the data key is sent to KMS
+resource "sakura_kms_ciphertext" "envelope" {
+  kms_id               = sakura_kms.foobar.id
+  plaintext_wo         = file("${path.module}/config.json")
+  plaintext_wo_version = 1
+  envelope             = true
+}
@@ -0,0 +1,24 @@
+resource "sakura_monitoring_suite_alert_rule_group" "foobar" {
+  alert_project_id  = "alert-project-resource-id"  # e.g. sakura_monitoring_suite_alert_project.foobar.id
+  metric_storage_id = "metric-storage-resource-id" # e.g. sakura_monitoring_suite_metric_storage.foobar.id
+  # Prometheus rule file. file("rules.yml") can also be used.
+  rules_yaml = <<-EOT
+    groups:
+      - name: node
+        rules:
+          - alert: HighLoad
+            expr: avg(node_load1) > 2
+            for: 5m
+            labels:
+              severity: warning
+            annotations:
+              summary: High load
+          - alert: HighLoad
+            expr: avg(node_load1) > 4
+            for: 10m
+            labels:
+              severity: critical
+          - alert: InstanceDown
+            expr: up == 0
+  EOT
+}
@@ -5,4 +5,19 @@ resource "sakura_secret_manager_secret" "foobar" {
   value_wo_version = 1
   // for backward compatibility
   //value = "secret value!"
-}
\ No newline at end of file
+}
+resource "sakura_secret_manager_secret" "db_password" {
+  name             = "db-password"
+  vault_id         = "secret_manager-resource-id" # e.g. sakura_secret_manager.foobar.id
+  value_wo         = "initial password"
+  value_wo_version = 1
+
+  // the new versions stored by the Workflow/Schedule are tracked without drift
+  rotation = {
+    // rotation_days must match the interval of the EventBus Schedule
+    rotation_days = 30
+    schedule_id   = "schedule-id" # e.g. sakura_eventbus_schedule.rotate.id
+    // or Workflow started outside of Terraform
+    //workflow_id = sakura_workflows.rotate.id
+  }
+}
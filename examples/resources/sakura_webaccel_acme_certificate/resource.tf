@@ -0,0 +1,9 @@
+resource "sakura_webaccel_acme_certificate" "foobar" {
+  site_id = "webaccel-site-id" # e.g. sakura_webaccel.foobar.id
+  dns_id  = "dns-zone-id"      # e.g. sakura_dns.foobar.id
+  domains = ["www.example.com"]
+  email   = "admin@example.com"
+
+  # Renew the certificate on apply when it expires within 30 days
+  min_days_remaining = 30
+}
@@ -0,0 +1,13 @@
+resource "sakura_webaccel_cache_purge" "foobar" {
+  site_id = "webaccel-site-id" # e.g. sakura_webaccel.foobar.id
+  # Purge the specified URLs only. Omit urls to purge the whole site.
+  urls = [
+    "https://www.example.com/index.html",
+    "https://www.example.com/assets/app.js",
+  ]
+
+  # The cache is purged again when any of the triggers are changed
+  triggers = {
+    index = "etag-of-index-html" # e.g. sakura_object_storage_object.index.etag
+  }
+}
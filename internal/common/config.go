@@ -4,10 +4,12 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -86,6 +88,7 @@ type Config struct {
 	ServicePrincipalKeyKID string
 	ServicePrivateKey      string
 	ServicePrivateKeyPath  string
+	CredentialProcess      string
 	Zone                   string
 	Zones                  []string
 	DefaultZone            string
@@ -99,6 +102,21 @@ type Config struct {
 	APIRequestRateLimit    int
 	TerraformVersion       string
 	Endpoints              map[string]string
+	ObjectStorage          ObjectStorageConfig
+	ReadOnly               bool
+	AllowedAccountIDs      []string
+	ForbiddenAccountIDs    []string
+	AllowedZones           []string
+}
+
+// ObjectStorageConfig is the default configuration for S3-compatible API of Object Storage
+type ObjectStorageConfig struct {
+	AccessKey string
+	SecretKey string
+	Region    string
+	Endpoint  string
+	Insecure  bool
+	ReadOnly  bool // プロバイダーのread_onlyの値。プロファイルや環境変数からは設定しない
 }
 
 // APIClient for SakuraCloud API
@@ -106,6 +124,7 @@ type APIClient struct {
 	iaas.APICaller
 	defaultZone                      string // 各リソースでzone未指定の場合に利用するゾーン。iaas.APIDefaultZoneとは別物。
 	zones                            []string
+	allowedZones                     []string
 	deletionWaiterTimeout            time.Duration
 	deletionWaiterPollingInterval    time.Duration
 	databaseWaitAfterCreateDuration  time.Duration
@@ -129,6 +148,8 @@ type APIClient struct {
 	SimpleNotificationClient         *simple_notification_api.Client
 	MonitoringSuiteClient            *monitoringsuiteapi.Client
 	WebaccelClient                   *webaccel.Client
+	ObjectStorageConfig              ObjectStorageConfig
+	dnsCache                         *dnsCache
 }
 
 func (c *APIClient) CheckReferencedOption() query.CheckReferencedOption {
@@ -164,6 +185,9 @@ func (c *Config) FillWith(other *Config) {
 	if c.ServicePrivateKeyPath == "" {
 		c.ServicePrivateKeyPath = other.ServicePrivateKeyPath
 	}
+	if c.CredentialProcess == "" {
+		c.CredentialProcess = other.CredentialProcess
+	}
 	if c.Zone == "" {
 		c.Zone = other.Zone
 	}
@@ -200,6 +224,31 @@ func (c *Config) FillWith(other *Config) {
 	if len(c.Endpoints) == 0 && len(other.Endpoints) > 0 {
 		c.Endpoints = other.Endpoints
 	}
+	if !c.ReadOnly {
+		c.ReadOnly = other.ReadOnly
+	}
+	c.ObjectStorage.FillWith(&other.ObjectStorage)
+}
+
+func (c *ObjectStorageConfig) FillWith(other *ObjectStorageConfig) {
+	if c.AccessKey == "" {
+		c.AccessKey = other.AccessKey
+	}
+	if c.SecretKey == "" {
+		c.SecretKey = other.SecretKey
+	}
+	if c.Region == "" {
+		c.Region = other.Region
+	}
+	if c.Endpoint == "" {
+		c.Endpoint = other.Endpoint
+	}
+	if !c.Insecure {
+		c.Insecure = other.Insecure
+	}
+	if !c.ReadOnly {
+		c.ReadOnly = other.ReadOnly
+	}
 }
 
 func (c *Config) FillWithDefault() {
@@ -269,6 +318,9 @@ func (c *Config) LoadFromProfile() (*Config, error) {
 	if v, ok := attrs["PrivateKeyPEMPath"].(string); ok {
 		conf.ServicePrivateKeyPath = v
 	}
+	if v, ok := attrs["CredentialProcess"].(string); ok {
+		conf.CredentialProcess = v
+	}
 	if v, ok := attrs["Zone"].(string); ok {
 		conf.Zone = v
 	}
@@ -313,10 +365,29 @@ func (c *Config) LoadFromProfile() (*Config, error) {
 	if v, ok := attrs["Endpoints"].(map[string]string); ok {
 		conf.Endpoints = v
 	}
+	if v, ok := attrs["ObjectStorageAccessKey"].(string); ok {
+		conf.ObjectStorage.AccessKey = v
+	}
+	if v, ok := attrs["ObjectStorageSecretKey"].(string); ok {
+		conf.ObjectStorage.SecretKey = v
+	}
+	if v, ok := attrs["ObjectStorageRegion"].(string); ok {
+		conf.ObjectStorage.Region = v
+	}
+	if v, ok := attrs["ObjectStorageEndpoint"].(string); ok {
+		conf.ObjectStorage.Endpoint = v
+	}
+	if v, ok := attrs["ObjectStorageInsecure"].(bool); ok {
+		conf.ObjectStorage.Insecure = v
+	}
 
 	return conf, nil
 }
 
+func (c *Config) hasCredentials() bool {
+	return c.AccessToken != "" || c.AccessTokenSecret != "" || c.ServicePrivateKey != "" || c.ServicePrivateKeyPath != ""
+}
+
 func (c *Config) validate() error {
 	var err error
 	if c.ServicePrivateKey != "" || c.ServicePrivateKeyPath != "" {
@@ -334,6 +405,15 @@ func (c *Config) validate() error {
 			err = multierror.Append(err, errors.New("secret is required"))
 		}
 	}
+	if len(c.AllowedAccountIDs) > 0 && len(c.ForbiddenAccountIDs) > 0 {
+		err = multierror.Append(err, errors.New("allowed_account_ids and forbidden_account_ids cannot be specified together"))
+	}
+	if len(c.AllowedZones) > 0 && !slices.Contains(c.AllowedZones, c.Zone) {
+		err = multierror.Append(err, fmt.Errorf("zone %q must be included in allowed_zones %v", c.Zone, c.AllowedZones))
+	}
+	if (c.ObjectStorage.AccessKey == "") != (c.ObjectStorage.SecretKey == "") {
+		err = multierror.Append(err, errors.New("object_storage.access_key and object_storage.secret_key must be specified together"))
+	}
 	return err
 }
 
@@ -345,8 +425,20 @@ func (c *Config) NewClient(envConf *Config) (*APIClient, error) {
 		// ref: https://docs.usacloud.jp/terraform/provider/#api
 		c.FillWith(envConf)
 		c.FillWith(profileConf)
-		c.FillWithDefault()
 	}
+	// 他に認証情報が指定されていない場合のみcredential_processの結果を利用する
+	var process *credentialProcess
+	var processCreds *ProcessCredentials
+	if c.CredentialProcess != "" && !c.hasCredentials() {
+		process = getCredentialProcess(c.CredentialProcess)
+		creds, err := process.Retrieve(context.Background(), false)
+		if err != nil {
+			return nil, err
+		}
+		c.FillWith(creds.toConfig())
+		processCreds = creds
+	}
+	c.FillWithDefault()
 	if err := c.validate(); err != nil {
 		return nil, err
 	}
@@ -384,16 +476,67 @@ func (c *Config) NewClient(envConf *Config) (*APIClient, error) {
 		UserAgent:            ua,
 		Trace:                enableHTTPTrace,
 	}
-	caller := api.NewCallerWithOptions(&api.CallerOptions{
-		Options:     callerOptions,
-		APIRootURL:  c.APIRootURL,
-		DefaultZone: c.DefaultZone,
-		TraceAPI:    enableAPITrace,
-	})
+	newCaller := func(options *client.Options) iaas.APICaller {
+		return api.NewCallerWithOptions(&api.CallerOptions{
+			Options:     options,
+			APIRootURL:  c.APIRootURL,
+			DefaultZone: c.DefaultZone,
+			TraceAPI:    enableAPITrace,
+		})
+	}
+	newSaclient := func(conf *Config) (*saclient.Client, error) {
+		theClient := &saclient.Client{}
+		if err := theClient.SetEnviron(conf.createSaclientEnvConfig()); err != nil {
+			return nil, fmt.Errorf("failed to create Sakura client via Envvars: %s", err.Error())
+		}
+		if conf.ReadOnly {
+			if err := theClient.SetWith(saclient.WithMiddleware(readOnlyMiddleware)); err != nil {
+				return nil, fmt.Errorf("failed to enable read_only mode: %s", err.Error())
+			}
+		}
+		return theClient, nil
+	}
+
+	var caller iaas.APICaller
+	theClient, err := newSaclient(c)
+	if err != nil {
+		return nil, err
+	}
+	if process != nil {
+		// 認証情報の有効期限切れや401の場合はcredential_processを再実行して新しい認証情報でリクエストする
+		caller = newCredentialProcessAPICaller(process, processCreds, func(creds *ProcessCredentials) iaas.APICaller {
+			options := *callerOptions
+			options.AccessToken = creds.AccessToken
+			options.AccessTokenSecret = creds.AccessTokenSecret
+			return newCaller(&options)
+		})
+		m := &credentialProcessMiddleware{
+			process: process,
+			creds:   processCreds,
+			newClient: func(creds *ProcessCredentials) (*saclient.Client, error) {
+				conf := *c
+				conf.AccessToken = creds.AccessToken
+				conf.AccessTokenSecret = creds.AccessTokenSecret
+				conf.ServicePrincipalID = creds.ServicePrincipalID
+				conf.ServicePrincipalKeyKID = creds.ServicePrincipalKeyKID
+				conf.ServicePrivateKey = creds.ServicePrivateKey
+				conf.ServicePrivateKeyPath = ""
+				return newSaclient(&conf)
+			},
+		}
+		if err := theClient.SetWith(saclient.WithMiddleware(m.Middleware)); err != nil {
+			return nil, fmt.Errorf("failed to set up credential_process: %s", err.Error())
+		}
+	} else {
+		caller = newCaller(callerOptions)
+	}
 
-	theClient := &saclient.Client{}
-	if err := theClient.SetEnviron(c.createSaclientEnvConfig()); err != nil {
-		return nil, fmt.Errorf("failed to create Sakura client via Envvars: %s", err.Error())
+	// read_only時はGET以外のリクエストをAPIに送信する前に拒否する
+	objectStorageConfig := c.ObjectStorage
+	if c.ReadOnly {
+		log.Printf("[INFO] read_only mode is enabled. Non-GET API requests are refused")
+		caller = newReadOnlyAPICaller(caller)
+		objectStorageConfig.ReadOnly = true
 	}
 
 	zones := c.Zones
@@ -470,6 +613,7 @@ func (c *Config) NewClient(envConf *Config) (*APIClient, error) {
 		APICaller:                        caller,
 		defaultZone:                      c.Zone,
 		zones:                            zones,
+		allowedZones:                     c.AllowedZones,
 		deletionWaiterTimeout:            deletionWaiterTimeout,
 		deletionWaiterPollingInterval:    deletionWaiterPollingInterval,
 		databaseWaitAfterCreateDuration:  databaseWaitAfterCreateDuration,
@@ -493,6 +637,8 @@ func (c *Config) NewClient(envConf *Config) (*APIClient, error) {
 		SimpleNotificationClient:         simpleNotificationClient,
 		MonitoringSuiteClient:            monitoringSuiteClient,
 		WebaccelClient:                   &webaccel.Client{Saclient: theClient},
+		ObjectStorageConfig:              objectStorageConfig,
+		dnsCache:                         newDNSCache(),
 	}, nil
 }
 
@@ -82,6 +82,19 @@ func TestConfig_NewClient_loadFromProfile(t *testing.T) {
 		},
 	}
 
+	objectStorageProfile := &saclient.Profile{
+		Name: "object-storage",
+		Attributes: map[string]any{
+			"AccessToken":            "token",
+			"AccessTokenSecret":      "secret",
+			"ObjectStorageAccessKey": "os-access-key",
+			"ObjectStorageSecretKey": "os-secret-key",
+			"ObjectStorageRegion":    "os-region",
+			"ObjectStorageEndpoint":  "http://localhost:9000",
+			"ObjectStorageInsecure":  true,
+		},
+	}
+
 	// プロファイル指定なし & デフォルトプロファイルなし
 	// プロファイル指定なし & デフォルトプロファイルあり
 	// プロファイル指定あり & 指定プロファイルが存在しない
@@ -332,6 +345,31 @@ func TestConfig_NewClient_loadFromProfile(t *testing.T) {
 				APIRequestRateLimit: testProfile.Attributes["HTTPRequestRateLimit"].(int),
 			},
 		},
+		{
+			scenario: "Object Storage values are loaded from the profile",
+			in: &common.Config{
+				Profile: "object-storage",
+				ObjectStorage: common.ObjectStorageConfig{
+					Region: "from config",
+				},
+			},
+			profiles: map[string]*saclient.Profile{
+				"object-storage": objectStorageProfile,
+			},
+			currentProfile: "object-storage",
+			expect: &common.Config{
+				Profile:           "object-storage",
+				AccessToken:       "token",
+				AccessTokenSecret: "secret",
+				ObjectStorage: common.ObjectStorageConfig{
+					AccessKey: "os-access-key",
+					SecretKey: "os-secret-key",
+					Region:    "from config",
+					Endpoint:  "http://localhost:9000",
+					Insecure:  true,
+				},
+			},
+		},
 	}
 
 	for _, tt := range cases {
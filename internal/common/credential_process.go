@@ -0,0 +1,276 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/saclient-go"
+)
+
+const (
+	credentialProcessTimeout = 1 * time.Minute
+	// 有効期限の直前に取得した認証情報を使わないようにするためのマージン
+	credentialProcessExpiryWindow = 1 * time.Minute
+)
+
+// ProcessCredentials is the JSON output of credential_process
+type ProcessCredentials struct {
+	AccessToken            string     `json:"token"`
+	AccessTokenSecret      string     `json:"secret"`
+	ServicePrincipalID     string     `json:"service_principal_id"`
+	ServicePrincipalKeyKID string     `json:"service_principal_key_kid"`
+	ServicePrivateKey      string     `json:"private_key"`
+	Expiration             *time.Time `json:"expiration,omitempty"`
+}
+
+func (c *ProcessCredentials) validate() error {
+	hasToken := c.AccessToken != "" || c.AccessTokenSecret != ""
+	hasKey := c.ServicePrincipalID != "" || c.ServicePrincipalKeyKID != "" || c.ServicePrivateKey != ""
+	switch {
+	case hasToken && hasKey:
+		return errors.New("either token/secret or service principal key material must be returned, not both")
+	case hasToken:
+		if c.AccessToken == "" || c.AccessTokenSecret == "" {
+			return errors.New("both token and secret are required")
+		}
+	case hasKey:
+		if c.ServicePrincipalID == "" || c.ServicePrincipalKeyKID == "" || c.ServicePrivateKey == "" {
+			return errors.New("service_principal_id, service_principal_key_kid and private_key are required")
+		}
+	default:
+		return errors.New("no credentials are returned")
+	}
+	return nil
+}
+
+func (c *ProcessCredentials) expired(now time.Time) bool {
+	return c.Expiration != nil && !now.Add(credentialProcessExpiryWindow).Before(*c.Expiration)
+}
+
+func (c *ProcessCredentials) toConfig() *Config {
+	return &Config{
+		AccessToken:            c.AccessToken,
+		AccessTokenSecret:      c.AccessTokenSecret,
+		ServicePrincipalID:     c.ServicePrincipalID,
+		ServicePrincipalKeyKID: c.ServicePrincipalKeyKID,
+		ServicePrivateKey:      c.ServicePrivateKey,
+	}
+}
+
+// credentialProcess runs the external command and caches its result until the expiration
+type credentialProcess struct {
+	command string
+
+	mu     sync.Mutex
+	cached *ProcessCredentials
+}
+
+// 同一コマンドの結果はプロバイダーのインスタンス(alias)間で共有する
+var credentialProcesses sync.Map
+
+func getCredentialProcess(command string) *credentialProcess {
+	p, _ := credentialProcesses.LoadOrStore(command, &credentialProcess{command: command})
+	return p.(*credentialProcess)
+}
+
+// Retrieve returns the cached credentials, or runs the command if they are not cached, expired or force is true
+func (p *credentialProcess) Retrieve(ctx context.Context, force bool) (*ProcessCredentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !force && p.cached != nil && !p.cached.expired(time.Now()) {
+		return p.cached, nil
+	}
+
+	creds, err := p.run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.cached = creds
+	return creds, nil
+}
+
+func (p *credentialProcess) run(ctx context.Context) (*ProcessCredentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", p.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	log.Printf("[DEBUG] Running credential_process")
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential_process failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var creds ProcessCredentials
+	if err := json.Unmarshal(stdout.Bytes(), &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse the output of credential_process as JSON: %w", err)
+	}
+	if err := creds.validate(); err != nil {
+		return nil, fmt.Errorf("invalid output of credential_process: %w", err)
+	}
+	if creds.expired(time.Now()) {
+		return nil, fmt.Errorf("credential_process returned expired credentials: expiration=%s", creds.Expiration.Format(time.RFC3339))
+	}
+	return &creds, nil
+}
+
+// credentialProcessAPICaller re-invokes credential_process when the credentials are expired or the iaas API returns 401
+type credentialProcessAPICaller struct {
+	process   *credentialProcess
+	newCaller func(creds *ProcessCredentials) iaas.APICaller
+
+	mu     sync.Mutex
+	creds  *ProcessCredentials
+	caller iaas.APICaller
+}
+
+func newCredentialProcessAPICaller(process *credentialProcess, creds *ProcessCredentials, newCaller func(*ProcessCredentials) iaas.APICaller) iaas.APICaller {
+	return &credentialProcessAPICaller{
+		process:   process,
+		newCaller: newCaller,
+		creds:     creds,
+		caller:    newCaller(creds),
+	}
+}
+
+func (c *credentialProcessAPICaller) current(ctx context.Context, force bool) (iaas.APICaller, error) {
+	creds, err := c.process.Retrieve(ctx, force)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if creds != c.creds {
+		c.creds = creds
+		c.caller = c.newCaller(creds)
+	}
+	return c.caller, nil
+}
+
+func (c *credentialProcessAPICaller) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	caller, err := c.current(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	data, err := caller.Do(ctx, method, uri, body)
+	if !isUnauthorizedError(err) {
+		return data, err
+	}
+
+	log.Printf("[INFO] API returned 401. Re-invoking credential_process")
+	caller, err = c.current(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	return caller.Do(ctx, method, uri, body)
+}
+
+func isUnauthorizedError(err error) bool {
+	var apiError iaas.APIError
+	return errors.As(err, &apiError) && apiError.ResponseCode() == http.StatusUnauthorized
+}
+
+// credentialProcessMiddleware re-invokes credential_process when the credentials are expired or the API returns 401.
+// saclient.Client can't change its credentials after populated, so the request is sent via a new client built with
+// the refreshed credentials instead of the rest of the middlewares.
+type credentialProcessMiddleware struct {
+	process   *credentialProcess
+	newClient func(creds *ProcessCredentials) (*saclient.Client, error)
+
+	mu     sync.Mutex
+	creds  *ProcessCredentials
+	client *saclient.Client // nil while the initial credentials are valid
+}
+
+func (m *credentialProcessMiddleware) current(ctx context.Context, force bool) (*saclient.Client, error) {
+	creds, err := m.process.Retrieve(ctx, force)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if creds != m.creds {
+		client, err := m.newClient(creds)
+		if err != nil {
+			return nil, err
+		}
+		m.creds = creds
+		m.client = client
+	}
+	return m.client, nil
+}
+
+func (m *credentialProcessMiddleware) Middleware(req *http.Request, pull func() (saclient.Middleware, bool)) (*http.Response, error) {
+	client, err := m.current(req.Context(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *http.Response
+	if client != nil {
+		res, err = client.Do(req)
+	} else {
+		next, ok := pull()
+		if !ok {
+			return nil, fmt.Errorf("no next middleware for %s %s", req.Method, req.URL.String())
+		}
+		res, err = next(req, pull)
+	}
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+
+	retry, err := cloneRequestForRetry(req)
+	if err != nil {
+		// リクエストボディを再送できない場合は401のレスポンスをそのまま返す
+		log.Printf("[WARN] API returned 401, but the request can't be retried: %s", err)
+		return res, nil
+	}
+	log.Printf("[INFO] API returned 401. Re-invoking credential_process")
+	client, err = m.current(req.Context(), true)
+	if err != nil {
+		return nil, err
+	}
+	res.Body.Close() //nolint:errcheck
+	return client.Do(retry)
+}
+
+func cloneRequestForRetry(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	retry.Header.Del("Authorization")
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("request body is not rewindable")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
+}
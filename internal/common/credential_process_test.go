@@ -0,0 +1,174 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCredentialProcess creates a script which prints the given JSON with the number of invocations as `%s`
+func writeCredentialProcess(t *testing.T, output string) (command string, invoked func() int) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process tests require sh")
+	}
+
+	dir := t.TempDir()
+	counter := filepath.Join(dir, "count")
+	script := filepath.Join(dir, "credential_process.sh")
+	body := fmt.Sprintf(`#!/bin/sh
+n=$(cat %[1]q 2>/dev/null || echo 0)
+n=$((n + 1))
+echo "$n" > %[1]q
+printf '%[2]s' "$n"
+`, counter, output)
+	require.NoError(t, os.WriteFile(script, []byte(body), 0o700))
+
+	return script, func() int {
+		data, err := os.ReadFile(counter)
+		if err != nil {
+			return 0
+		}
+		var n int
+		fmt.Sscanf(strings.TrimSpace(string(data)), "%d", &n) //nolint
+		return n
+	}
+}
+
+func TestConfig_NewClient_credentialProcess(t *testing.T) {
+	defer initTestProfileDir()()
+
+	// token-1 is revoked, so the API returns 401
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, _, _ := r.BasicAuth()
+		if user == "token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"is_fatal":true,"status":"401 Unauthorized","error_code":"unauthorized"}`)) //nolint
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`)) //nolint
+	}))
+	defer server.Close()
+
+	command, invoked := writeCredentialProcess(t, `{"token":"token-%s","secret":"secret"}`)
+	conf := &common.Config{
+		CredentialProcess: command,
+		APIRootURL:        server.URL,
+		RetryMax:          1,
+	}
+	client, err := conf.NewClient(&common.Config{})
+	require.NoError(t, err)
+	require.Equal(t, 1, invoked())
+	require.Equal(t, "token-1", conf.AccessToken)
+
+	_, err = client.Do(context.Background(), http.MethodGet, server.URL+"/zone/is1a/api/cloud/1.1/server", nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, invoked())
+
+	// the refreshed credentials are shared with saclient
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/kms/keys", nil)
+	require.NoError(t, err)
+	res, err := client.SaClient.Do(req)
+	require.NoError(t, err)
+	res.Body.Close() //nolint
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, 2, invoked())
+
+	// saclient also re-invokes the command on 401
+	command, invoked = writeCredentialProcess(t, `{"token":"token-%s","secret":"secret"}`)
+	conf = &common.Config{
+		CredentialProcess: command,
+		APIRootURL:        server.URL,
+		RetryMax:          1,
+	}
+	client, err = conf.NewClient(&common.Config{})
+	require.NoError(t, err)
+
+	req, err = http.NewRequest(http.MethodPost, server.URL+"/kms/keys", strings.NewReader(`{}`))
+	require.NoError(t, err)
+	res, err = client.SaClient.Do(req)
+	require.NoError(t, err)
+	res.Body.Close() //nolint
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.Equal(t, 2, invoked())
+}
+
+func TestConfig_NewClient_credentialProcessExpiration(t *testing.T) {
+	defer initTestProfileDir()()
+
+	expiration := time.Now().Add(90 * time.Second).UTC().Format(time.RFC3339)
+	command, invoked := writeCredentialProcess(t, `{"token":"token-%s","secret":"secret","expiration":"`+expiration+`"}`)
+	conf := &common.Config{CredentialProcess: command}
+	_, err := conf.NewClient(&common.Config{})
+	require.NoError(t, err)
+	require.Equal(t, 1, invoked())
+
+	// cached until the expiration
+	conf = &common.Config{CredentialProcess: command}
+	_, err = conf.NewClient(&common.Config{})
+	require.NoError(t, err)
+	require.Equal(t, 1, invoked())
+}
+
+func TestConfig_NewClient_credentialProcessErrors(t *testing.T) {
+	defer initTestProfileDir()()
+
+	cases := []struct {
+		scenario string
+		output   string
+		errmsg   string
+	}{
+		{
+			scenario: "invalid JSON",
+			output:   `token`,
+			errmsg:   "failed to parse the output of credential_process as JSON",
+		},
+		{
+			scenario: "missing secret",
+			output:   `{"token":"token"}`,
+			errmsg:   "both token and secret are required",
+		},
+		{
+			scenario: "incomplete service principal",
+			output:   `{"service_principal_id":"123","private_key":"dummy"}`,
+			errmsg:   "service_principal_id, service_principal_key_kid and private_key are required",
+		},
+		{
+			scenario: "expired",
+			output:   `{"token":"token","secret":"secret","expiration":"2020-01-01T00:00:00Z"}`,
+			errmsg:   "credential_process returned expired credentials",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.scenario, func(t *testing.T) {
+			command, _ := writeCredentialProcess(t, tt.output)
+			conf := &common.Config{CredentialProcess: command}
+			_, err := conf.NewClient(&common.Config{})
+			require.ErrorContains(t, err, tt.errmsg)
+		})
+	}
+
+	t.Run("not invoked when credentials are specified", func(t *testing.T) {
+		command, invoked := writeCredentialProcess(t, `{"token":"token-%s","secret":"secret"}`)
+		conf := &common.Config{CredentialProcess: command}
+		_, err := conf.NewClient(&common.Config{AccessToken: "token", AccessTokenSecret: "secret"})
+		require.NoError(t, err)
+		require.Equal(t, 0, invoked())
+		require.Equal(t, "token", conf.AccessToken)
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+)
+
+// dnsCache はDNSゾーンの読み込み結果をAPIClient単位でキャッシュする。
+// APIClientはTerraformのグラフウォーク(refresh/plan/apply)毎に生成されるため、キャッシュの寿命も1回のウォークに限られる。
+// 数千件のsakura_dns_record/sakura_dns_record_setを持つゾーンでも、リフレッシュ時のDNSOp.Readはゾーン毎に1回で済む。
+type dnsCache struct {
+	mu      sync.Mutex
+	entries map[string]*dnsCacheEntry
+}
+
+type dnsCacheEntry struct {
+	mu  sync.Mutex // 同じゾーンへの同時読み込みを1回のAPI呼び出しにまとめる
+	dns *iaas.DNS
+}
+
+func newDNSCache() *dnsCache {
+	return &dnsCache{entries: make(map[string]*dnsCacheEntry)}
+}
+
+func (c *dnsCache) entry(id iaastypes.ID) *dnsCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[id.String()]
+	if !ok {
+		e = &dnsCacheEntry{}
+		c.entries[id.String()] = e
+	}
+	return e
+}
+
+// ReadDNS reads the DNS zone through the per-client cache.
+// Use this for refreshing records. The update paths should read the zone with DNSOp directly while holding SakuraMutexKV,
+// and then pass the result to StoreDNS.
+func (c *APIClient) ReadDNS(ctx context.Context, id iaastypes.ID) (*iaas.DNS, error) {
+	if c.dnsCache == nil {
+		return iaas.NewDNSOp(c).Read(ctx, id)
+	}
+
+	e := c.dnsCache.entry(id)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.dns == nil {
+		dns, err := iaas.NewDNSOp(c).Read(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		e.dns = dns
+	}
+	return copyDNS(e.dns), nil
+}
+
+// StoreDNS replaces the cached DNS zone with the result of an update.
+func (c *APIClient) StoreDNS(dns *iaas.DNS) {
+	if c.dnsCache == nil || dns == nil {
+		return
+	}
+
+	e := c.dnsCache.entry(dns.ID)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.dns = copyDNS(dns)
+}
+
+// ForgetDNS drops the cached DNS zone so that the next ReadDNS calls the API.
+func (c *APIClient) ForgetDNS(id iaastypes.ID) {
+	if c.dnsCache == nil {
+		return
+	}
+
+	e := c.dnsCache.entry(id)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.dns = nil
+}
+
+// copyDNS は呼び出し元がRecordsへappendしてもキャッシュに影響しないよう、スライスを複製する
+func copyDNS(dns *iaas.DNS) *iaas.DNS {
+	copied := *dns
+	copied.Records = slices.Clone(dns.Records)
+	return &copied
+}
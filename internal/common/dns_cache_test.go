@@ -0,0 +1,77 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIClient_ReadDNS(t *testing.T) {
+	defer initTestProfileDir()()
+
+	var requested atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"CommonServiceItem":{"ID":"123456789012","Status":{"Zone":"example.com"},"Settings":{"DNS":{"ResourceRecordSets":[{"Name":"www","Type":"A","RData":"192.0.2.1","TTL":3600}]}}}}`)) //nolint
+	}))
+	defer server.Close()
+
+	conf := &common.Config{
+		AccessToken:       "token",
+		AccessTokenSecret: "secret",
+		APIRootURL:        server.URL,
+		RetryMax:          1,
+	}
+	client, err := conf.NewClient(&common.Config{})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	id := iaastypes.ID(123456789012)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			dns, err := client.ReadDNS(ctx, id)
+			if assert.NoError(t, err) {
+				assert.Len(t, dns.Records, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	require.EqualValues(t, 1, requested.Load())
+
+	// 返された値を変更してもキャッシュには影響しない
+	dns, err := client.ReadDNS(ctx, id)
+	require.NoError(t, err)
+	dns.Records = append(dns.Records, &iaas.DNSRecord{Name: "www2", Type: iaastypes.DNSRecordTypes.A, RData: "192.0.2.2", TTL: 3600})
+	cached, err := client.ReadDNS(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, cached.Records, 1)
+
+	client.StoreDNS(dns)
+	cached, err = client.ReadDNS(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, cached.Records, 2)
+	require.EqualValues(t, 1, requested.Load())
+
+	client.ForgetDNS(id)
+	cached, err = client.ReadDNS(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, cached.Records, 1)
+	require.EqualValues(t, 2, requested.Load())
+}
@@ -0,0 +1,62 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+)
+
+// CheckAccountID verifies the account of the credentials with allowed_account_ids/forbidden_account_ids.
+// AuthStatus API is called only when either of them is specified.
+func (c *Config) CheckAccountID(ctx context.Context, client *APIClient) error {
+	if len(c.AllowedAccountIDs) == 0 && len(c.ForbiddenAccountIDs) == 0 {
+		return nil
+	}
+
+	authStatus, err := iaas.NewAuthStatusOp(client).Read(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to resolve the account via AuthStatus API: %w", err)
+	}
+	accountID := authStatus.AccountID.String()
+
+	if len(c.AllowedAccountIDs) > 0 && !slices.Contains(c.AllowedAccountIDs, accountID) {
+		return fmt.Errorf("account ID %q (%s) is not included in allowed_account_ids %v", accountID, authStatus.AccountName, c.AllowedAccountIDs)
+	}
+	if slices.Contains(c.ForbiddenAccountIDs, accountID) {
+		return fmt.Errorf("account ID %q (%s) is included in forbidden_account_ids", accountID, authStatus.AccountName)
+	}
+	return nil
+}
+
+func (c *APIClient) checkAllowedZone(zone string) error {
+	if len(c.allowedZones) == 0 || slices.Contains(c.allowedZones, zone) {
+		return nil
+	}
+	return fmt.Errorf("zone %q is not included in allowed_zones %v of the provider configuration", zone, c.allowedZones)
+}
+
+// ValidateAllowedZoneInPlan rejects the resource whose zone is outside of allowed_zones at plan time.
+// Unknown zone is resolved to the provider's default zone in GetZone, which is already validated in the provider configuration.
+func ValidateAllowedZoneInPlan(ctx context.Context, client *APIClient, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	if client == nil || plan.Raw.IsNull() {
+		return
+	}
+
+	var zone types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	if diags.HasError() || zone.IsNull() || zone.IsUnknown() {
+		return
+	}
+	if err := client.checkAllowedZone(zone.ValueString()); err != nil {
+		diags.AddAttributeError(path.Root("zone"), "Zone Not Allowed", err.Error())
+	}
+}
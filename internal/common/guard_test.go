@@ -0,0 +1,114 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_CheckAccountID(t *testing.T) {
+	defer initTestProfileDir()()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/auth-status") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"Account":{"ID":"111111111111","Name":"staging"}}`)) //nolint
+	}))
+	defer server.Close()
+
+	cases := []struct {
+		scenario  string
+		allowed   []string
+		forbidden []string
+		errre     *regexp.Regexp
+	}{
+		{
+			scenario: "no restriction",
+		},
+		{
+			scenario: "allowed account",
+			allowed:  []string{"111111111111", "222222222222"},
+		},
+		{
+			scenario: "not allowed account",
+			allowed:  []string{"222222222222"},
+			errre:    regexp.MustCompile(`account ID "111111111111" \(staging\) is not included in allowed_account_ids`),
+		},
+		{
+			scenario:  "not forbidden account",
+			forbidden: []string{"222222222222"},
+		},
+		{
+			scenario:  "forbidden account",
+			forbidden: []string{"111111111111"},
+			errre:     regexp.MustCompile(`account ID "111111111111" \(staging\) is included in forbidden_account_ids`),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.scenario, func(t *testing.T) {
+			conf := &common.Config{
+				AccessToken:         "token",
+				AccessTokenSecret:   "secret",
+				APIRootURL:          server.URL,
+				RetryMax:            1,
+				AllowedAccountIDs:   tt.allowed,
+				ForbiddenAccountIDs: tt.forbidden,
+			}
+			client, err := conf.NewClient(&common.Config{})
+			require.NoError(t, err)
+
+			err = conf.CheckAccountID(context.Background(), client)
+			if tt.errre == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Regexp(t, tt.errre, err.Error())
+			}
+		})
+	}
+}
+
+func TestConfig_NewClient_allowedZones(t *testing.T) {
+	defer initTestProfileDir()()
+
+	newConfig := func() *common.Config {
+		return &common.Config{
+			AccessToken:       "token",
+			AccessTokenSecret: "secret",
+			Zone:              "is1a",
+			AllowedZones:      []string{"is1a", "is1b"},
+		}
+	}
+
+	client, err := newConfig().NewClient(&common.Config{})
+	require.NoError(t, err)
+
+	var diags diag.Diagnostics
+	require.Equal(t, "is1a", common.GetZone(types.StringNull(), client, &diags))
+	require.Equal(t, "is1b", common.GetZone(types.StringValue("is1b"), client, &diags))
+	require.False(t, diags.HasError())
+
+	require.Equal(t, "", common.GetZone(types.StringValue("tk1a"), client, &diags))
+	require.True(t, diags.HasError())
+	require.Contains(t, diags.Errors()[0].Detail(), `zone "tk1a" is not included in allowed_zones`)
+
+	conf := newConfig()
+	conf.Zone = "tk1a"
+	_, err = conf.NewClient(&common.Config{})
+	require.ErrorContains(t, err, `zone "tk1a" must be included in allowed_zones`)
+}
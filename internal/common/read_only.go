@@ -0,0 +1,101 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/saclient-go"
+)
+
+// ReadOnlyError is returned when a mutating API request is sent while read_only mode is enabled
+type ReadOnlyError struct {
+	Method string
+	URL    string
+	// TypeName and Operation are the Terraform type name (e.g. sakura_server) and the operation (e.g. Create) that sent the request.
+	// These are empty if the request is sent outside of the RPCs of the provider.
+	TypeName  string
+	Operation string
+}
+
+func newReadOnlyError(ctx context.Context, method, url string) *ReadOnlyError {
+	err := &ReadOnlyError{Method: method, URL: url}
+	if op, ok := ctx.Value(operationContextKey{}).(operationContextValue); ok {
+		err.TypeName = op.typeName
+		err.Operation = op.operation
+	}
+	return err
+}
+
+func (e *ReadOnlyError) Error() string {
+	if e.TypeName != "" {
+		return fmt.Sprintf("read_only mode is enabled in the provider configuration: %s of %s refused to send %s request to %s", e.Operation, e.TypeName, e.Method, e.URL)
+	}
+	return fmt.Sprintf("read_only mode is enabled in the provider configuration: refused to send %s request to %s", e.Method, e.URL)
+}
+
+type operationContextKey struct{}
+
+type operationContextValue struct {
+	typeName  string
+	operation string
+}
+
+// ContextWithOperation returns a context that records the Terraform type name and the operation being processed.
+// The values are included in ReadOnlyError.
+func ContextWithOperation(ctx context.Context, typeName, operation string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, operationContextValue{typeName: typeName, operation: operation})
+}
+
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// readOnlyAPICaller refuses non-GET requests of the iaas API
+type readOnlyAPICaller struct {
+	iaas.APICaller
+}
+
+func newReadOnlyAPICaller(caller iaas.APICaller) iaas.APICaller {
+	return &readOnlyAPICaller{APICaller: caller}
+}
+
+func (c *readOnlyAPICaller) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	if !isReadOnlyMethod(method) {
+		return nil, newReadOnlyError(ctx, method, uri)
+	}
+	return c.APICaller.Do(ctx, method, uri, body)
+}
+
+// readOnlyMiddleware refuses non-GET requests sent via saclient. This is prepended to the middlewares of saclient,
+// so requests for authentication (e.g. token exchange of service principal) are not affected.
+func readOnlyMiddleware(req *http.Request, pull func() (saclient.Middleware, bool)) (*http.Response, error) {
+	if !isReadOnlyMethod(req.Method) {
+		return nil, newReadOnlyError(req.Context(), req.Method, req.URL.String())
+	}
+	next, ok := pull()
+	if !ok {
+		return nil, fmt.Errorf("no next middleware for %s %s", req.Method, req.URL.String())
+	}
+	return next(req, pull)
+}
+
+// readOnlyTransport refuses non-GET requests of the S3-compatible API of Object Storage
+type readOnlyTransport struct {
+	base http.RoundTripper
+}
+
+func NewReadOnlyTransport(base http.RoundTripper) http.RoundTripper {
+	return &readOnlyTransport{base: base}
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOnlyMethod(req.Method) {
+		return nil, newReadOnlyError(req.Context(), req.Method, req.URL.String())
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,111 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package common_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_NewClient_readOnly(t *testing.T) {
+	defer initTestProfileDir()()
+
+	var requested atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`)) //nolint
+	}))
+	defer server.Close()
+
+	newClient := func(t *testing.T, readOnly bool) *common.APIClient {
+		conf := &common.Config{
+			AccessToken:       "token",
+			AccessTokenSecret: "secret",
+			APIRootURL:        server.URL,
+			RetryMax:          1,
+			ReadOnly:          readOnly,
+		}
+		client, err := conf.NewClient(&common.Config{})
+		require.NoError(t, err)
+		return client
+	}
+
+	t.Run("iaas APICaller", func(t *testing.T) {
+		requested.Store(0)
+		client := newClient(t, true)
+		ctx := context.Background()
+
+		_, err := client.Do(ctx, http.MethodGet, server.URL+"/zone/is1a/api/cloud/1.1/server", nil)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, requested.Load())
+
+		for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodDelete} {
+			_, err = client.Do(ctx, method, server.URL+"/zone/is1a/api/cloud/1.1/server", nil)
+			var roErr *common.ReadOnlyError
+			require.ErrorAs(t, err, &roErr, method)
+			require.Equal(t, method, roErr.Method)
+			require.Contains(t, err.Error(), "/api/cloud/1.1/server")
+			require.Empty(t, roErr.TypeName)
+		}
+		require.EqualValues(t, 1, requested.Load())
+	})
+
+	t.Run("saclient", func(t *testing.T) {
+		requested.Store(0)
+		client := newClient(t, true)
+
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/kms/keys", nil)
+		require.NoError(t, err)
+		res, err := client.SaClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close() //nolint
+		require.EqualValues(t, 1, requested.Load())
+
+		ctx := common.ContextWithOperation(context.Background(), "sakura_kms", "Delete")
+		req, err = http.NewRequestWithContext(ctx, http.MethodDelete, server.URL+"/kms/keys/123", nil)
+		require.NoError(t, err)
+		_, err = client.SaClient.Do(req) //nolint:bodyclose
+		var roErr *common.ReadOnlyError
+		require.ErrorAs(t, err, &roErr)
+		require.Equal(t, http.MethodDelete, roErr.Method)
+		require.Equal(t, "sakura_kms", roErr.TypeName)
+		require.Equal(t, "Delete", roErr.Operation)
+		require.Contains(t, err.Error(), "Delete of sakura_kms refused to send DELETE request")
+		require.EqualValues(t, 1, requested.Load())
+		require.True(t, client.ObjectStorageConfig.ReadOnly)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		requested.Store(0)
+		client := newClient(t, false)
+
+		_, err := client.Do(context.Background(), http.MethodPost, server.URL+"/zone/is1a/api/cloud/1.1/server", nil)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, requested.Load())
+		require.False(t, client.ObjectStorageConfig.ReadOnly)
+	})
+}
+
+func TestReadOnlyTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: common.NewReadOnlyTransport(http.DefaultTransport)}
+
+	res, err := client.Head(server.URL + "/bucket/key")
+	require.NoError(t, err)
+	res.Body.Close() //nolint
+
+	_, err = client.Post(server.URL+"/bucket/key", "text/plain", nil) //nolint:bodyclose
+	var roErr *common.ReadOnlyError
+	require.ErrorAs(t, err, &roErr)
+	require.Equal(t, http.MethodPost, roErr.Method)
+}
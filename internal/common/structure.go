@@ -81,6 +81,10 @@ func GetZone(zone basetypes.StringValue, client *APIClient, diags *diag.Diagnost
 		diags.AddError("Get zone error", err.Error())
 		return ""
 	}
+	if err := client.checkAllowedZone(z); err != nil {
+		diags.AddError("Get zone error", err.Error())
+		return ""
+	}
 
 	return z
 }
@@ -6,10 +6,16 @@
 import (
 	"context"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -71,23 +77,37 @@
 )
 
 type sakuraProviderModel struct {
-	Profile                types.String `tfsdk:"profile"`
-	AccessToken            types.String `tfsdk:"token"`
-	AccessTokenSecret      types.String `tfsdk:"secret"`
-	ServicePrincipalID     types.String `tfsdk:"service_principal_id"`
-	ServicePrincipalKeyID  types.String `tfsdk:"service_principal_key_id"`
-	ServicePrincipalKeyKID types.String `tfsdk:"service_principal_key_kid"`
-	ServicePrivateKeyPath  types.String `tfsdk:"service_private_key_path"`
-	Zone                   types.String `tfsdk:"zone"`
-	Zones                  types.List   `tfsdk:"zones"`
-	DefaultZone            types.String `tfsdk:"default_zone"`
-	APIRootURL             types.String `tfsdk:"api_root_url"`
-	RetryMax               types.Int32  `tfsdk:"retry_max"`
-	RetryWaitMax           types.Int64  `tfsdk:"retry_wait_max"`
-	RetryWaitMin           types.Int64  `tfsdk:"retry_wait_min"`
-	APIRequestTimeout      types.Int64  `tfsdk:"api_request_timeout"`
-	APIRequestRateLimit    types.Int32  `tfsdk:"api_request_rate_limit"`
-	TraceMode              types.String `tfsdk:"trace"`
+	Profile                types.String                      `tfsdk:"profile"`
+	AccessToken            types.String                      `tfsdk:"token"`
+	AccessTokenSecret      types.String                      `tfsdk:"secret"`
+	ServicePrincipalID     types.String                      `tfsdk:"service_principal_id"`
+	ServicePrincipalKeyID  types.String                      `tfsdk:"service_principal_key_id"`
+	ServicePrincipalKeyKID types.String                      `tfsdk:"service_principal_key_kid"`
+	ServicePrivateKeyPath  types.String                      `tfsdk:"service_private_key_path"`
+	CredentialProcess      types.String                      `tfsdk:"credential_process"`
+	Zone                   types.String                      `tfsdk:"zone"`
+	Zones                  types.List                        `tfsdk:"zones"`
+	DefaultZone            types.String                      `tfsdk:"default_zone"`
+	APIRootURL             types.String                      `tfsdk:"api_root_url"`
+	RetryMax               types.Int32                       `tfsdk:"retry_max"`
+	RetryWaitMax           types.Int64                       `tfsdk:"retry_wait_max"`
+	RetryWaitMin           types.Int64                       `tfsdk:"retry_wait_min"`
+	APIRequestTimeout      types.Int64                       `tfsdk:"api_request_timeout"`
+	APIRequestRateLimit    types.Int32                       `tfsdk:"api_request_rate_limit"`
+	TraceMode              types.String                      `tfsdk:"trace"`
+	ReadOnly               types.Bool                        `tfsdk:"read_only"`
+	AllowedAccountIDs      types.List                        `tfsdk:"allowed_account_ids"`
+	ForbiddenAccountIDs    types.List                        `tfsdk:"forbidden_account_ids"`
+	AllowedZones           types.List                        `tfsdk:"allowed_zones"`
+	ObjectStorage          *sakuraProviderObjectStorageModel `tfsdk:"object_storage"`
+}
+
+type sakuraProviderObjectStorageModel struct {
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+	Region    types.String `tfsdk:"region"`
+	Endpoint  types.String `tfsdk:"endpoint"`
+	Insecure  types.Bool   `tfsdk:"insecure"`
 }
 
 func New(version string) func() provider.Provider {
@@ -101,6 +121,13 @@ type sakuraProvider struct {
 	client  *common.APIClient
 }
 
+var (
+	_ provider.Provider                       = &sakuraProvider{}
+	_ provider.ProviderWithActions            = &sakuraProvider{}
+	_ provider.ProviderWithEphemeralResources = &sakuraProvider{}
+	_ provider.ProviderWithFunctions          = &sakuraProvider{}
+)
+
 func (p *sakuraProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "sakura"
 	resp.Version = p.version
@@ -137,6 +164,10 @@ func (p *sakuraProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The private key path for service principal of your SakuraCloud account. This is used for service principal based access. It can also be sourced from the `SAKURA_PRIVATE_KEY_PATH` environment variables, or via a shared credentials file if `profile` is specified",
 			},
+			"credential_process": schema.StringAttribute{
+				Optional:    true,
+				Description: "The command to obtain credentials from an external process such as a secret vault. The command must print a JSON object with `token`/`secret`, or `service_principal_id`/`service_principal_key_kid`/`private_key` to stdout. `expiration` in RFC3339 format can be added to the JSON to re-invoke the command before the credentials expire. The command is also re-invoked when the API returns `401`. This is used only when no other credentials are specified. It can also be sourced from the `SAKURA_CREDENTIAL_PROCESS` environment variables, or via a shared credentials file if `profile` is specified",
+			},
 			"zone": schema.StringAttribute{
 				Optional:    true,
 				Description: "The name of zone to use as default. It must be provided, but it can also be sourced from the `SAKURA_ZONE`/`SAKURACLOUD_ZONE` environment variables, or via a shared credentials file if `profile` is specified",
@@ -190,6 +221,64 @@ func (p *sakuraProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The flag to enable output trace log. It can also be sourced from the `SAKURA_TRACE`/`SAKURACLOUD_TRACE` environment variables, or via a shared credentials file if `profile` is specified",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "The flag to enable read-only mode. If true, the provider refuses any API request other than GET before it is sent and reports the resource and the operation (e.g. `Create`) that sent it, so that `terraform plan` can be run safely with production credentials. It can also be sourced from the `SAKURA_READ_ONLY` environment variables",
+			},
+			"allowed_account_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A list of account IDs allowed to be used. If the account of the credentials is not included, the provider returns an error in the configuration. Conflicts with `forbidden_account_ids`",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("forbidden_account_ids")),
+				},
+			},
+			"forbidden_account_ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A list of account IDs forbidden to be used. If the account of the credentials is included, the provider returns an error in the configuration. Conflicts with `allowed_account_ids`",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("allowed_account_ids")),
+				},
+			},
+			"allowed_zones": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A list of zone names allowed to be used. Resources and data sources in other zones are rejected at plan time. `zone` must be included in this list",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"object_storage": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The default configuration for S3-compatible API of Object Storage. These values are used when Object Storage resources don't specify `access_key`/`secret_key`/`region`/`endpoint`",
+				Attributes: map[string]schema.Attribute{
+					"access_key": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The access key for the Object Storage. It can also be sourced from the `SAKURA_OBJECT_STORAGE_ACCESS_KEY` environment variables, or via a shared credentials file if `profile` is specified",
+					},
+					"secret_key": schema.StringAttribute{
+						Optional:    true,
+						Sensitive:   true,
+						Description: "The secret key for the Object Storage. It can also be sourced from the `SAKURA_OBJECT_STORAGE_SECRET_KEY` environment variables, or via a shared credentials file if `profile` is specified",
+					},
+					"region": schema.StringAttribute{
+						Optional:    true,
+						Description: "The region of the Object Storage. It can also be sourced from the `SAKURA_OBJECT_STORAGE_REGION` environment variables, or via a shared credentials file if `profile` is specified. Default:`jp-north-1`",
+					},
+					"endpoint": schema.StringAttribute{
+						Optional:    true,
+						Description: "The endpoint of the S3-compatible API. `http://` or `https://` scheme can be prepended to use a custom endpoint such as local MinIO. It can also be sourced from the `SAKURA_OBJECT_STORAGE_ENDPOINT` environment variables, or via a shared credentials file if `profile` is specified. Default:`s3.isk01.sakurastorage.jp`",
+					},
+					"insecure": schema.BoolAttribute{
+						Optional:    true,
+						Description: "The flag to use HTTP instead of HTTPS for the S3-compatible API. It can also be sourced from the `SAKURA_OBJECT_STORAGE_INSECURE` environment variables, or via a shared credentials file if `profile` is specified",
+					},
+				},
+			},
 		},
 	}
 }
@@ -213,6 +302,7 @@ func (p *sakuraProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		ServicePrincipalKeyKID: envvar.StringFromEnvMulti([]string{"SAKURA_SERVICE_PRINCIPAL_KEY_KID", "SAKURA_SERVICE_PRINCIPAL_KEY_ID"}, ""),
 		ServicePrivateKey:      envvar.StringFromEnv("SAKURA_PRIVATE_KEY", ""),
 		ServicePrivateKeyPath:  envvar.StringFromEnv("SAKURA_PRIVATE_KEY_PATH", ""),
+		CredentialProcess:      envvar.StringFromEnv("SAKURA_CREDENTIAL_PROCESS", ""),
 		Zone:                   envvar.StringFromEnvMulti([]string{"SAKURA_ZONE", "SAKURACLOUD_ZONE"}, ""),
 		DefaultZone:            envvar.StringFromEnvMulti([]string{"SAKURA_DEFAULT_ZONE", "SAKURACLOUD_DEFAULT_ZONE"}, ""),
 		APIRootURL:             envvar.StringFromEnvMulti([]string{"SAKURA_API_ROOT_URL", "SAKURACLOUD_API_ROOT_URL"}, ""),
@@ -223,6 +313,14 @@ func (p *sakuraProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		APIRequestRateLimit:    envvar.IntFromEnvMulti([]string{"SAKURA_RATE_LIMIT", "SAKURACLOUD_RATE_LIMIT"}, 0),
 		Zones:                  envvar.StringSliceFromEnvMulti([]string{"SAKURA_ZONES", "SAKURACLOUD_ZONES"}, nil),
 		Endpoints:              endpoints,
+		ObjectStorage: common.ObjectStorageConfig{
+			AccessKey: envvar.StringFromEnv("SAKURA_OBJECT_STORAGE_ACCESS_KEY", ""),
+			SecretKey: envvar.StringFromEnv("SAKURA_OBJECT_STORAGE_SECRET_KEY", ""),
+			Region:    envvar.StringFromEnv("SAKURA_OBJECT_STORAGE_REGION", ""),
+			Endpoint:  envvar.StringFromEnv("SAKURA_OBJECT_STORAGE_ENDPOINT", ""),
+			Insecure:  boolFromEnv("SAKURA_OBJECT_STORAGE_INSECURE"),
+		},
+		ReadOnly: boolFromEnv("SAKURA_READ_ONLY"),
 	}
 
 	var config sakuraProviderModel
@@ -246,6 +344,7 @@ func (p *sakuraProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		ServicePrincipalID:     config.ServicePrincipalID.ValueString(),
 		ServicePrincipalKeyKID: keyKID,
 		ServicePrivateKeyPath:  config.ServicePrivateKeyPath.ValueString(),
+		CredentialProcess:      config.CredentialProcess.ValueString(),
 		Zone:                   config.Zone.ValueString(),
 		DefaultZone:            config.DefaultZone.ValueString(),
 		APIRootURL:             config.APIRootURL.ValueString(),
@@ -255,9 +354,22 @@ func (p *sakuraProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		APIRequestTimeout:      int(config.APIRequestTimeout.ValueInt64()),
 		APIRequestRateLimit:    int(config.APIRequestRateLimit.ValueInt32()),
 		TraceMode:              config.TraceMode.ValueString(),
+		ReadOnly:               config.ReadOnly.ValueBool(),
 		Zones:                  common.TlistToStrings(config.Zones),
+		AllowedAccountIDs:      common.TlistToStrings(config.AllowedAccountIDs),
+		ForbiddenAccountIDs:    common.TlistToStrings(config.ForbiddenAccountIDs),
+		AllowedZones:           common.TlistToStrings(config.AllowedZones),
 		TerraformVersion:       req.TerraformVersion,
 	}
+	if config.ObjectStorage != nil {
+		cfg.ObjectStorage = common.ObjectStorageConfig{
+			AccessKey: config.ObjectStorage.AccessKey.ValueString(),
+			SecretKey: config.ObjectStorage.SecretKey.ValueString(),
+			Region:    config.ObjectStorage.Region.ValueString(),
+			Endpoint:  config.ObjectStorage.Endpoint.ValueString(),
+			Insecure:  config.ObjectStorage.Insecure.ValueBool(),
+		}
+	}
 	// 他のパラメータとは違いプロファイルをロードするために、SAKURA_PROFILEの値だけは優先する
 	if cfg.Profile == "" {
 		cfg.Profile = envConf.Profile
@@ -268,10 +380,24 @@ func (p *sakuraProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		resp.Diagnostics.AddError("failed to create Sakura client", err.Error())
 		return
 	}
+	if err := cfg.CheckAccountID(ctx, client); err != nil {
+		resp.Diagnostics.AddError("Account Check Error", err.Error())
+		return
+	}
 
 	p.client = client
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
+	resp.ActionData = client
+}
+
+func boolFromEnv(key string) bool {
+	v, err := strconv.ParseBool(envvar.StringFromEnv(key, "false"))
+	if err != nil {
+		return false
+	}
+	return v
 }
 
 func (p *sakuraProvider) DataSources(_ context.Context) []func() datasource.DataSource {
@@ -316,12 +442,16 @@ func (p *sakuraProvider) DataSources(_ context.Context) []func() datasource.Data
 		cloudhsm.NewCloudHSMPeerDataSource,
 		container_registry.NewContainerRegistryDataSource,
 		database.NewDatabaseDataSource,
+		database.NewDatabaseBackupsDataSource,
 		dedicated_storage.NewDedicatedStorageDataSource,
 		disk.NewDiskDataSource,
 		dns.NewDNSDataSource,
+		dns.NewDNSZoneFileDataSource,
 		dsr_lb.NewDSRLBDataSource,
+		dsr_lb.NewDSRLBStatusDataSource,
 		enhanced_db.NewEnhancedDBDataSource,
 		enhanced_lb.NewEnhancedLBDataSource,
+		enhanced_lb.NewEnhancedLBHealthDataSource,
 		eventbus.NewEventBusProcessConfigurationDataSource,
 		eventbus.NewEventBusScheduleDataSource,
 		eventbus.NewEventBusTriggerDataSource,
@@ -333,6 +463,8 @@ func (p *sakuraProvider) DataSources(_ context.Context) []func() datasource.Data
 		iam.NewIdRoleDataSource,
 		iam.NewOrgIDPolicyDataSource,
 		iam.NewPolicyDataSource,
+		iam.NewPolicyDocumentDataSource,
+		iam.NewEffectivePermissionsDataSource,
 		iam.NewProjectApiKeyDataSource,
 		iam.NewProjectDataSource,
 		iam.NewRoleDataSource,
@@ -380,6 +512,8 @@ func (p *sakuraProvider) DataSources(_ context.Context) []func() datasource.Data
 		vpn_router.NewVPNRouterDataSource,
 		vswitch.NewvSwitchDataSource,
 		webaccel.NewWebAccelDataSource,
+		webaccel.NewWebAccelAccessLogsDataSource,
+		webaccel.NewWebAccelUsageDataSource,
 		workflows.NewPlanDataSource,
 		workflows.NewSubscriptionDataSource,
 		workflows.NewWorkflowsDataSource,
@@ -427,14 +561,20 @@ func (p *sakuraProvider) Resources(_ context.Context) []func() resource.Resource
 		container_registry.NewContainerRegistryResource,
 		database.NewDatabaseReadReplicaResource,
 		database.NewDatabaseResource,
+		database.NewDatabaseRestoreResource,
 		dedicated_storage.NewDedicatedStorageResource,
 		disk.NewDiskResource,
 		dns.NewDNSRecordResource,
+		dns.NewDNSRecordSetResource,
 		dns.NewDNSResource,
+		dns.NewDNSZoneFileResource,
 		dsr_lb.NewDSRLBResource,
 		enhanced_db.NewEnhancedDBResource,
 		enhanced_lb.NewEnhancedLBACMEResource,
+		enhanced_lb.NewEnhancedLBCertificateResource,
 		enhanced_lb.NewEnhancedLBResource,
+		enhanced_lb.NewEnhancedLBRuleResource,
+		enhanced_lb.NewEnhancedLBServerResource,
 		eventbus.NewEventBusProcessConfigurationResource,
 		eventbus.NewEventBusScheduleResource,
 		eventbus.NewEventBusTriggerResource,
@@ -444,9 +584,12 @@ func (p *sakuraProvider) Resources(_ context.Context) []func() resource.Resource
 		iam.NewGroupResource,
 		iam.NewOrgIDPolicyResource,
 		iam.NewPolicyResource,
+		iam.NewPolicyBindingResource,
+		iam.NewPolicyMemberResource,
 		iam.NewProjectApiKeyResource,
 		iam.NewProjectResource,
 		iam.NewServicePrincipalResource,
+		iam.NewServicePrincipalKeyResource,
 		iam.NewSsoResource,
 		iam.NewUserResource,
 		iam.NewUserProvisioningResource,
@@ -454,12 +597,14 @@ func (p *sakuraProvider) Resources(_ context.Context) []func() resource.Resource
 		internet.NewInternetResource,
 		ipv4_ptr.NewIPv4PtrResource,
 		kms.NewKMSResource,
+		kms.NewKMSCiphertextResource,
 		local_router.NewLocalRouterResource,
 		monitoring_suite.NewAlertProjectResource,
 		monitoring_suite.NewAlertLogMeasureRuleResource,
 		monitoring_suite.NewAlertNotificationRoutingResource,
 		monitoring_suite.NewAlertNotificationTargetResource,
 		monitoring_suite.NewAlertRuleResource,
+		monitoring_suite.NewAlertRuleGroupResource,
 		monitoring_suite.NewDashboardResource,
 		monitoring_suite.NewLogRoutingResource,
 		monitoring_suite.NewLogStorageAccessKeyResource,
@@ -502,8 +647,10 @@ func (p *sakuraProvider) Resources(_ context.Context) []func() resource.Resource
 		vpn_router.NewVPNRouterResource,
 		vswitch.NewvSwitchResource,
 		webaccel.NewWebAccelResource,
+		webaccel.NewWebAccelACMECertificateResource,
 		webaccel.NewWebAccelACLResource,
 		webaccel.NewWebAccelActivationResource,
+		webaccel.NewWebAccelCachePurgeResource,
 		webaccel.NewWebAccelCertificateResource,
 		workflows.NewSubscriptionResource,
 		workflows.NewWorkflowsResource,
@@ -511,3 +658,26 @@ func (p *sakuraProvider) Resources(_ context.Context) []func() resource.Resource
 		// ...他のリソースも同様に追加...
 	}
 }
+
+func (p *sakuraProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		iam.NewServicePrincipalKeyPairEphemeralResource,
+		kms.NewKMSDataKeyEphemeralResource,
+		kms.NewKMSDecryptEphemeralResource,
+		object_storage.NewObjectStoragePresignedURLEphemeralResource,
+		ssh_key.NewSSHKeyGenEphemeralResource,
+	}
+}
+
+func (p *sakuraProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		database.NewDatabaseRestoreAction,
+		webaccel.NewWebAccelPurgeCacheAction,
+	}
+}
+
+func (p *sakuraProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		object_storage.NewObjectStoragePresignFunction,
+	}
+}
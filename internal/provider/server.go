@@ -0,0 +1,99 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sakura
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+)
+
+// protocol6Server はフレームワークが返すプロトコルサーバーのうち、このプロバイダーで利用するRPCをまとめたもの
+type protocol6Server interface {
+	tfprotov6.ProviderServer
+	tfprotov6.ActionServer
+}
+
+// operationServer は各RPCのcontextに操作対象の種別と操作名を設定する。
+// read_onlyモードでAPIリクエストを拒否した際に、どのリソースのどの操作で拒否されたかをエラーに含めるために利用する
+type operationServer struct {
+	protocol6Server
+}
+
+var _ protocol6Server = &operationServer{}
+
+// NewProtocol6ServerWithError returns a factory of the protocol version 6 server for the provider,
+// which is the same as providerserver.NewProtocol6WithError except that it records the type and the operation of each RPC in the context.
+func NewProtocol6ServerWithError(p provider.Provider) func() (tfprotov6.ProviderServer, error) {
+	return func() (tfprotov6.ProviderServer, error) {
+		server, err := providerserver.NewProtocol6WithError(p)()
+		if err != nil {
+			return nil, err
+		}
+		s, ok := server.(protocol6Server)
+		if !ok {
+			return nil, fmt.Errorf("unexpected protocol server type: %T", server)
+		}
+		return &operationServer{protocol6Server: s}, nil
+	}
+}
+
+func (s *operationServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	return s.protocol6Server.ReadResource(common.ContextWithOperation(ctx, req.TypeName, "Read"), req)
+}
+
+func (s *operationServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	return s.protocol6Server.PlanResourceChange(common.ContextWithOperation(ctx, req.TypeName, "ModifyPlan"), req)
+}
+
+func (s *operationServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	operation := "Update"
+	switch {
+	case isNullDynamicValue(req.PriorState):
+		operation = "Create"
+	case isNullDynamicValue(req.PlannedState):
+		operation = "Delete"
+	}
+	return s.protocol6Server.ApplyResourceChange(common.ContextWithOperation(ctx, req.TypeName, operation), req)
+}
+
+func (s *operationServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	return s.protocol6Server.ImportResourceState(common.ContextWithOperation(ctx, req.TypeName, "ImportState"), req)
+}
+
+func (s *operationServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	return s.protocol6Server.ReadDataSource(common.ContextWithOperation(ctx, req.TypeName, "Read"), req)
+}
+
+func (s *operationServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	return s.protocol6Server.OpenEphemeralResource(common.ContextWithOperation(ctx, req.TypeName, "Open"), req)
+}
+
+func (s *operationServer) RenewEphemeralResource(ctx context.Context, req *tfprotov6.RenewEphemeralResourceRequest) (*tfprotov6.RenewEphemeralResourceResponse, error) {
+	return s.protocol6Server.RenewEphemeralResource(common.ContextWithOperation(ctx, req.TypeName, "Renew"), req)
+}
+
+func (s *operationServer) CloseEphemeralResource(ctx context.Context, req *tfprotov6.CloseEphemeralResourceRequest) (*tfprotov6.CloseEphemeralResourceResponse, error) {
+	return s.protocol6Server.CloseEphemeralResource(common.ContextWithOperation(ctx, req.TypeName, "Close"), req)
+}
+
+func (s *operationServer) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionServerStream, error) {
+	return s.protocol6Server.InvokeAction(common.ContextWithOperation(ctx, req.ActionType, "Invoke"), req)
+}
+
+// isNullDynamicValue はstateがnull(作成前または削除後)かを判定する。
+// Terraformはstateをmsgpackでエンコードして送信し、nullは0xc0の1バイトになる
+func isNullDynamicValue(v *tfprotov6.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+	if len(v.MsgPack) > 0 {
+		return len(v.MsgPack) == 1 && v.MsgPack[0] == 0xc0
+	}
+	return len(v.JSON) == 0 || string(v.JSON) == "null"
+}
@@ -0,0 +1,69 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package sakura
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+// applyRecorder はApplyResourceChangeに渡されたcontextからread_onlyモードのエラーを生成して記録する
+type applyRecorder struct {
+	protocol6Server
+	err error
+}
+
+func (r *applyRecorder) ApplyResourceChange(ctx context.Context, _ *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com/", nil)
+	if err != nil {
+		return nil, err
+	}
+	_, r.err = common.NewReadOnlyTransport(http.DefaultTransport).RoundTrip(req) //nolint:bodyclose
+	return &tfprotov6.ApplyResourceChangeResponse{}, nil
+}
+
+func TestOperationServer_ApplyResourceChange(t *testing.T) {
+	typ := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	dynamicValue := func(id *string) *tfprotov6.DynamicValue {
+		var v tftypes.Value
+		if id == nil {
+			v = tftypes.NewValue(typ, nil)
+		} else {
+			v = tftypes.NewValue(typ, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, *id)})
+		}
+		dv, err := tfprotov6.NewDynamicValue(typ, v)
+		require.NoError(t, err)
+		return &dv
+	}
+	id := "123456789012"
+
+	cases := map[string]struct {
+		prior, planned *tfprotov6.DynamicValue
+	}{
+		"Create": {prior: dynamicValue(nil), planned: dynamicValue(&id)},
+		"Update": {prior: dynamicValue(&id), planned: dynamicValue(&id)},
+		"Delete": {prior: dynamicValue(&id), planned: dynamicValue(nil)},
+	}
+	for operation, tc := range cases {
+		recorder := &applyRecorder{}
+		server := &operationServer{protocol6Server: recorder}
+		_, err := server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+			TypeName:     "sakura_server",
+			PriorState:   tc.prior,
+			PlannedState: tc.planned,
+		})
+		require.NoError(t, err)
+
+		var roErr *common.ReadOnlyError
+		require.ErrorAs(t, recorder.err, &roErr, operation)
+		require.Equal(t, "sakura_server", roErr.TypeName)
+		require.Equal(t, operation, roErr.Operation)
+	}
+}
@@ -41,6 +41,7 @@ type archiveResource struct {
 	_ resource.Resource                = &archiveResource{}
 	_ resource.ResourceWithConfigure   = &archiveResource{}
 	_ resource.ResourceWithImportState = &archiveResource{}
+	_ resource.ResourceWithModifyPlan  = &archiveResource{}
 )
 
 func NewArchiveResource() resource.Resource {
@@ -59,6 +60,10 @@ func (r *archiveResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = apiclient
 }
 
+func (r *archiveResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type archiveResourceModel struct {
 	common.SakuraBaseModel
 	Zone                     types.String   `tfsdk:"zone"`
@@ -36,6 +36,7 @@ type autoBackupResource struct {
 	_ resource.Resource                = &autoBackupResource{}
 	_ resource.ResourceWithConfigure   = &autoBackupResource{}
 	_ resource.ResourceWithImportState = &autoBackupResource{}
+	_ resource.ResourceWithModifyPlan  = &autoBackupResource{}
 )
 
 func NewAutoBackupResource() resource.Resource {
@@ -54,6 +55,10 @@ func (r *autoBackupResource) Configure(ctx context.Context, req resource.Configu
 	r.client = apiclient
 }
 
+func (r *autoBackupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type autoBackupResourceModel struct {
 	common.SakuraBaseModel
 	Zone         types.String   `tfsdk:"zone"`
@@ -27,6 +27,7 @@ type bridgeResource struct {
 	_ resource.Resource                = &bridgeResource{}
 	_ resource.ResourceWithConfigure   = &bridgeResource{}
 	_ resource.ResourceWithImportState = &bridgeResource{}
+	_ resource.ResourceWithModifyPlan  = &bridgeResource{}
 )
 
 func NewBridgeResource() resource.Resource {
@@ -45,6 +46,10 @@ func (r *bridgeResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = apiclient
 }
 
+func (r *bridgeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type bridgeResourceModel struct {
 	bridgeBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
@@ -39,6 +39,7 @@ type cdromResource struct {
 	_ resource.Resource                = &cdromResource{}
 	_ resource.ResourceWithConfigure   = &cdromResource{}
 	_ resource.ResourceWithImportState = &cdromResource{}
+	_ resource.ResourceWithModifyPlan  = &cdromResource{}
 )
 
 func NewCDROMResource() resource.Resource {
@@ -57,6 +58,10 @@ func (r *cdromResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = apiclient
 }
 
+func (r *cdromResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type cdromResourceModel struct {
 	common.SakuraBaseModel
 	Zone         types.String   `tfsdk:"zone"`
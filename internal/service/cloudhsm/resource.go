@@ -27,6 +27,7 @@ type cloudHSMResource struct {
 	_ resource.Resource                = &cloudHSMResource{}
 	_ resource.ResourceWithConfigure   = &cloudHSMResource{}
 	_ resource.ResourceWithImportState = &cloudHSMResource{}
+	_ resource.ResourceWithModifyPlan  = &cloudHSMResource{}
 )
 
 func NewCloudHSMResource() resource.Resource {
@@ -45,6 +46,10 @@ func (r *cloudHSMResource) Configure(ctx context.Context, req resource.Configure
 	r.client = apiclient
 }
 
+func (r *cloudHSMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type cloudHSMResourceModel struct {
 	cloudHSMBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
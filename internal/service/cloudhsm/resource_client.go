@@ -29,6 +29,7 @@ type cloudHSMClientResource struct {
 	_ resource.Resource                = &cloudHSMClientResource{}
 	_ resource.ResourceWithConfigure   = &cloudHSMClientResource{}
 	_ resource.ResourceWithImportState = &cloudHSMClientResource{}
+	_ resource.ResourceWithModifyPlan  = &cloudHSMClientResource{}
 )
 
 func NewCloudHSMClientResource() resource.Resource {
@@ -47,6 +48,10 @@ func (r *cloudHSMClientResource) Configure(ctx context.Context, req resource.Con
 	r.client = apiclient
 }
 
+func (r *cloudHSMClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type cloudHSMClientResourceModel struct {
 	cloudHSMClientBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
@@ -27,6 +27,7 @@ type cloudHSMLicenseResource struct {
 	_ resource.Resource                = &cloudHSMLicenseResource{}
 	_ resource.ResourceWithConfigure   = &cloudHSMLicenseResource{}
 	_ resource.ResourceWithImportState = &cloudHSMLicenseResource{}
+	_ resource.ResourceWithModifyPlan  = &cloudHSMLicenseResource{}
 )
 
 func NewCloudHSMLicenseResource() resource.Resource {
@@ -45,6 +46,10 @@ func (r *cloudHSMLicenseResource) Configure(ctx context.Context, req resource.Co
 	r.client = apiclient
 }
 
+func (r *cloudHSMLicenseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type cloudHSMLicenseResourceModel struct {
 	cloudHSMLicenseBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
@@ -30,6 +30,7 @@ type cloudHSMPeerResource struct {
 	_ resource.Resource                = &cloudHSMPeerResource{}
 	_ resource.ResourceWithConfigure   = &cloudHSMPeerResource{}
 	_ resource.ResourceWithImportState = &cloudHSMPeerResource{}
+	_ resource.ResourceWithModifyPlan  = &cloudHSMPeerResource{}
 )
 
 func NewCloudHSMPeerResource() resource.Resource {
@@ -48,6 +49,10 @@ func (r *cloudHSMPeerResource) Configure(ctx context.Context, req resource.Confi
 	r.client = apiclient
 }
 
+func (r *cloudHSMPeerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type cloudHSMPeerResourceModel struct {
 	cloudHSMPeerBaseModel
 	RouterID    types.String   `tfsdk:"router_id"`
@@ -0,0 +1,95 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type databaseRestoreAction struct {
+	client *common.APIClient
+}
+
+var (
+	_ action.Action              = &databaseRestoreAction{}
+	_ action.ActionWithConfigure = &databaseRestoreAction{}
+)
+
+func NewDatabaseRestoreAction() action.Action {
+	return &databaseRestoreAction{}
+}
+
+func (a *databaseRestoreAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_restore"
+}
+
+func (a *databaseRestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	a.client = apiclient
+}
+
+type databaseRestoreActionModel struct {
+	DatabaseID      types.String `tfsdk:"database_id"`
+	Zone            types.String `tfsdk:"zone"`
+	BackupCreatedAt types.String `tfsdk:"backup_created_at"`
+}
+
+func (a *databaseRestoreAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Database to restore",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of zone that the Database is in (e.g. `is1a`, `tk1a`)",
+			},
+			"backup_created_at": schema.StringAttribute{
+				Required:    true,
+				Description: "The time when the backup to restore was taken, in RFC3339 format. This can be found in `created_at` of `sakura_database_backups`",
+				Validators: []validator.String{
+					sacloudvalidator.StringFuncValidator(validateDatabaseBackupCreatedAt),
+				},
+			},
+		},
+		MarkdownDescription: "Restores a Database appliance from a backup and waits for the appliance to be up. The current data of the Database is overwritten by the backup. " +
+			"Requires Terraform 1.14 or later. Use `sakura_database_restore` resource with older versions.",
+	}
+}
+
+func (a *databaseRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data databaseRestoreActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := common.GetZone(data.Zone, a.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbID := data.DatabaseID.ValueString()
+	backup := data.BackupCreatedAt.ValueString()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("restoring Database[%s] from backup taken at %s", dbID, backup)})
+
+	if err := restoreDatabaseBackup(ctx, a.client, zone, common.SakuraCloudID(dbID), backup); err != nil {
+		resp.Diagnostics.AddError("Invoke: API Error", err.Error())
+	}
+}
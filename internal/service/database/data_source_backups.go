@@ -0,0 +1,125 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type databaseBackupsDataSource struct {
+	client *common.APIClient
+}
+
+var (
+	_ datasource.DataSource              = &databaseBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &databaseBackupsDataSource{}
+)
+
+func NewDatabaseBackupsDataSource() datasource.DataSource {
+	return &databaseBackupsDataSource{}
+}
+
+func (d *databaseBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_backups"
+}
+
+func (d *databaseBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.client = apiclient
+}
+
+type databaseBackupsDataSourceModel struct {
+	ID         types.String                 `tfsdk:"id"`
+	DatabaseID types.String                 `tfsdk:"database_id"`
+	Zone       types.String                 `tfsdk:"zone"`
+	Backups    []databaseBackupHistoryModel `tfsdk:"backups"`
+}
+
+type databaseBackupHistoryModel struct {
+	CreatedAt    types.String `tfsdk:"created_at"`
+	RecoveredAt  types.String `tfsdk:"recovered_at"`
+	Availability types.String `tfsdk:"availability"`
+	Size         types.Int64  `tfsdk:"size"`
+}
+
+func (d *databaseBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the Database",
+			},
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Database",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+			},
+			"zone": common.SchemaDataSourceZone("Database"),
+			"backups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The backup history of the Database. This includes the backups taken by both `backup` and `continuous_backup`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time when the backup was taken, in RFC3339 format. Use this value to restore the backup with `sakura_database_restore`",
+						},
+						"recovered_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The time when the Database was last restored from the backup, in RFC3339 format",
+						},
+						"availability": schema.StringAttribute{
+							Computed:    true,
+							Description: "The availability of the backup",
+						},
+						"size": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The size of the backup in bytes",
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Get the backup history of a Database appliance.",
+	}
+}
+
+func (d *databaseBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data databaseBackupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := common.GetZone(data.Zone, d.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.DatabaseID.ValueString()
+	status, err := iaas.NewDatabaseOp(d.client).Status(ctx, zone, common.SakuraCloudID(id))
+	if err != nil {
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read status of Database[%s]: %s", id, err))
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.Zone = types.StringValue(zone)
+	data.Backups = flattenDatabaseBackupHistories(status.Backups)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
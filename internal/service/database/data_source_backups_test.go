@@ -0,0 +1,59 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDataSourceDatabaseBackups_basic(t *testing.T) {
+	resourceName := "data.sakura_database_backups.foobar"
+	rand := test.RandomName()
+	password := test.RandomPassword()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDataSourceDatabaseBackups_basic, rand, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sakura_database.foobar", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "zone", "sakura_database.foobar", "zone"),
+					// 作成直後のデータベースにはバックアップが存在しない
+					resource.TestCheckResourceAttr(resourceName, "backups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraDataSourceDatabaseBackups_basic = `
+resource "sakura_vswitch" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_database" "foobar" {
+  name     = "{{ .arg0 }}"
+  username = "defuser"
+  password = "{{ .arg1 }}"
+
+  network_interface = {
+    vswitch_id    = sakura_vswitch.foobar.id
+    ip_address    = "192.168.101.101"
+    netmask       = 24
+    gateway       = "192.168.101.1"
+  }
+  backup = {
+    days_of_week = ["mon", "tue"]
+    time         = "00:00"
+  }
+}
+
+data "sakura_database_backups" "foobar" {
+  database_id = sakura_database.foobar.id
+}`
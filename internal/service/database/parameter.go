@@ -0,0 +1,124 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+)
+
+// setDatabaseParameters は作成直後のデータベースのメタ情報でparametersを検証し、問題がなければ設定して反映する。
+// 作成時は再起動を伴わないため、再起動が必要なパラメータの警告は返さない
+func setDatabaseParameters(ctx context.Context, client iaas.APICaller, zone string, id iaastypes.ID, parameters map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(parameters) == 0 {
+		return diags
+	}
+
+	dbOp := iaas.NewDatabaseOp(client)
+	current, err := dbOp.GetParameter(ctx, zone, id)
+	if err != nil {
+		diags.AddError("Create: API Error", fmt.Sprintf("failed to read parameters of Database[%s]: %s", id, err))
+		return diags
+	}
+	if validated := validateDatabaseParameters(parameters, nil, current.MetaInfo); validated.HasError() {
+		diags.Append(validated.Errors()...)
+		return diags
+	}
+
+	// SetParameterにはメタ情報のLabelではなくNameを指定する
+	settings := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		idx := slices.IndexFunc(current.MetaInfo, func(m *iaas.DatabaseParameterMeta) bool { return m != nil && m.Label == k })
+		settings[current.MetaInfo[idx].Name] = v
+	}
+	if err := dbOp.SetParameter(ctx, zone, id, settings); err != nil {
+		diags.AddError("Create: API Error", fmt.Sprintf("failed to set parameters of Database[%s]: %s", id, err))
+		return diags
+	}
+	if err := dbOp.Config(ctx, zone, id); err != nil {
+		diags.AddError("Create: API Error", fmt.Sprintf("failed to apply parameters of Database[%s]: %s", id, err))
+	}
+	return diags
+}
+
+// validateDatabaseParameters はGetParameterで取得したメタ情報を元にparametersのキーと値を検証する。
+// 再起動が必要なパラメータ(Reboot=static)が変更される場合は警告を返す
+func validateDatabaseParameters(planned, current map[string]string, metas []*iaas.DatabaseParameterMeta) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keys := make([]string, 0, len(planned))
+	for k := range planned {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	var rebootKeys []string
+	for _, k := range keys {
+		v := planned[k]
+		attrPath := path.Root("parameters").AtMapKey(k)
+
+		idx := slices.IndexFunc(metas, func(m *iaas.DatabaseParameterMeta) bool { return m != nil && m.Label == k })
+		if idx < 0 {
+			diags.AddAttributeError(attrPath, "Invalid Database Parameter",
+				fmt.Sprintf("%q is not a valid parameter for the Database. Valid parameters are: %s", k, strings.Join(databaseParameterLabels(metas), ", ")))
+			continue
+		}
+		meta := metas[idx]
+
+		if err := validateDatabaseParameterValue(meta, v); err != nil {
+			diags.AddAttributeError(attrPath, "Invalid Database Parameter", fmt.Sprintf("invalid value for %q: %s", k, err))
+			continue
+		}
+
+		if cv, ok := current[k]; (!ok || cv != v) && meta.Reboot == "static" {
+			rebootKeys = append(rebootKeys, k)
+		}
+	}
+
+	if len(rebootKeys) > 0 {
+		diags.AddAttributeWarning(path.Root("parameters"), "Database Restart Required",
+			fmt.Sprintf("changing the parameters [%s] restarts the Database", strings.Join(rebootKeys, ", ")))
+	}
+	return diags
+}
+
+func validateDatabaseParameterValue(meta *iaas.DatabaseParameterMeta, value string) error {
+	if meta.MaxLen > 0 && len(value) > meta.MaxLen {
+		return fmt.Errorf("length must be at most %d, got %d", meta.MaxLen, len(value))
+	}
+	if meta.Type != "number" {
+		return nil
+	}
+
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("must be a number, got %q", value)
+	}
+	// Min/Maxが共に0の場合は範囲が定義されていない
+	if (meta.Min != 0 || meta.Max != 0) && (v < meta.Min || v > meta.Max) {
+		return fmt.Errorf("must be between %s and %s, got %s",
+			strconv.FormatFloat(meta.Min, 'f', -1, 64), strconv.FormatFloat(meta.Max, 'f', -1, 64), value)
+	}
+	return nil
+}
+
+func databaseParameterLabels(metas []*iaas.DatabaseParameterMeta) []string {
+	var labels []string
+	for _, m := range metas {
+		if m != nil {
+			labels = append(labels, m.Label)
+		}
+	}
+	slices.Sort(labels)
+	return labels
+}
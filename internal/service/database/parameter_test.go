@@ -0,0 +1,79 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"testing"
+
+	"github.com/sacloud/iaas-api-go"
+	"github.com/stretchr/testify/require"
+)
+
+var testDatabaseParameterMeta = []*iaas.DatabaseParameterMeta{
+	{Type: "number", Label: "max_connections", Min: 10, Max: 1000, Reboot: "static"},
+	{Type: "number", Label: "work_mem", Min: 64, Max: 2147483647, MaxLen: 10, Reboot: "dynamic"},
+	{Type: "string", Label: "event_scheduler", Reboot: "dynamic"},
+}
+
+func TestValidateDatabaseParameters(t *testing.T) {
+	current := map[string]string{"max_connections": "100", "work_mem": "4096"}
+
+	t.Run("valid", func(t *testing.T) {
+		diags := validateDatabaseParameters(map[string]string{"max_connections": "100", "work_mem": "8192", "event_scheduler": "ON"}, current, testDatabaseParameterMeta)
+		require.False(t, diags.HasError())
+		require.Empty(t, diags.Warnings())
+	})
+
+	t.Run("restart required", func(t *testing.T) {
+		diags := validateDatabaseParameters(map[string]string{"max_connections": "200", "work_mem": "8192"}, current, testDatabaseParameterMeta)
+		require.False(t, diags.HasError())
+		require.Len(t, diags.Warnings(), 1)
+		require.Equal(t, "Database Restart Required", diags.Warnings()[0].Summary())
+		require.Equal(t, "changing the parameters [max_connections] restarts the Database", diags.Warnings()[0].Detail())
+	})
+
+	t.Run("restart required only when static parameter changes", func(t *testing.T) {
+		// staticのmax_connectionsが変わらない場合は、dynamicのwork_memが変わっても警告しない
+		diags := validateDatabaseParameters(map[string]string{"max_connections": "100", "work_mem": "8192"}, current, testDatabaseParameterMeta)
+		require.False(t, diags.HasError())
+		require.Empty(t, diags.Warnings())
+
+		// 現在設定されていないstaticのパラメータを追加する場合も変更として扱う
+		diags = validateDatabaseParameters(map[string]string{"max_connections": "100"}, map[string]string{}, testDatabaseParameterMeta)
+		require.False(t, diags.HasError())
+		require.Len(t, diags.Warnings(), 1)
+		require.Equal(t, "changing the parameters [max_connections] restarts the Database", diags.Warnings()[0].Detail())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		diags := validateDatabaseParameters(map[string]string{
+			"max_connection":  "100",
+			"max_connections": "1",
+			"work_mem":        "foo",
+		}, current, testDatabaseParameterMeta)
+
+		var details []string
+		for _, d := range diags.Errors() {
+			details = append(details, d.Detail())
+		}
+		require.Equal(t, []string{
+			`"max_connection" is not a valid parameter for the Database. Valid parameters are: event_scheduler, max_connections, work_mem`,
+			`invalid value for "max_connections": must be between 10 and 1000, got 1`,
+			`invalid value for "work_mem": must be a number, got "foo"`,
+		}, details)
+		require.Empty(t, diags.Warnings())
+	})
+}
+
+func TestValidateDatabaseParameterValue(t *testing.T) {
+	meta := testDatabaseParameterMeta[1]
+	require.NoError(t, validateDatabaseParameterValue(meta, "64"))
+	require.NoError(t, validateDatabaseParameterValue(meta, "2147483647"))
+	require.EqualError(t, validateDatabaseParameterValue(meta, "21474836470"), "length must be at most 10, got 11")
+	require.EqualError(t, validateDatabaseParameterValue(meta, "63"), "must be between 64 and 2147483647, got 63")
+
+	// Min/Maxが定義されていない場合は範囲を検証しない
+	require.NoError(t, validateDatabaseParameterValue(&iaas.DatabaseParameterMeta{Type: "number"}, "-1"))
+	require.NoError(t, validateDatabaseParameterValue(testDatabaseParameterMeta[2], "OFF"))
+}
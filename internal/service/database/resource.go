@@ -42,6 +42,7 @@ type databaseResource struct {
 	_ resource.Resource                = &databaseResource{}
 	_ resource.ResourceWithConfigure   = &databaseResource{}
 	_ resource.ResourceWithImportState = &databaseResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseResource{}
 )
 
 func NewDatabaseResource() resource.Resource {
@@ -60,6 +61,36 @@ func (d *databaseResource) Configure(ctx context.Context, req resource.Configure
 	d.client = apiclient
 }
 
+func (d *databaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, d.client, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	// パラメータのメタ情報はデータベースの種類やバージョン毎に異なり、作成済みのアプライアンスからしか取得できない。
+	// このためplan時の検証は更新時のみ行い、作成時はCreateでパラメータを設定する前に検証する
+	var id, zone types.String
+	var planned, current types.Map
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parameters"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("parameters"), &current)...)
+	if resp.Diagnostics.HasError() || planned.IsNull() || planned.IsUnknown() || planned.Equal(current) {
+		return
+	}
+
+	parameters, err := iaas.NewDatabaseOp(d.client).GetParameter(ctx, zone.ValueString(), common.ExpandSakuraCloudID(id))
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("ModifyPlan: API Error", fmt.Sprintf("failed to read parameters of Database[%s]: %s", id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(validateDatabaseParameters(common.TmapToStrMap(planned), common.TmapToStrMap(current), parameters.MetaInfo)...)
+}
+
 type databaseResourceModel struct {
 	databaseBaseModel
 	Password          types.String   `tfsdk:"password"`
@@ -252,7 +283,8 @@ func (r *databaseResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				ElementType: types.StringType,
 				Optional:    true,
 				Computed:    true,
-				Description: "The map for setting RDBMS-specific parameters. Valid keys can be found with the `usacloud database list-parameters` command",
+				Description: "The map for setting RDBMS-specific parameters. Valid keys can be found with the `usacloud database list-parameters` command. " +
+					"The keys and values are validated with the parameter metadata of the Database before they are set. When updating, the validation is done at plan time and a warning is shown if the change restarts the Database",
 			},
 			"disk":             common.SchemaResourceEncryptionDisk("Database"),
 			"monitoring_suite": common.SchemaResourceMonitoringSuite("Database"),
@@ -286,6 +318,8 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 
 	dbBuilder := expandDatabaseBuilder(&plan, &config, r.client)
 	dbBuilder.Zone = zone
+	// パラメータはメタ情報で検証してから設定するため、Builderでは設定しない
+	dbBuilder.Parameters = nil
 	db, err := dbBuilder.Build(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to create Database: %s", err))
@@ -296,11 +330,15 @@ func (r *databaseResource) Create(ctx context.Context, req resource.CreateReques
 	// この挙動はテストなどで問題となる。このためここで少しsleepすることで対応する。
 	time.Sleep(databaseWaitAfterCreateDuration)
 
+	// パラメータの検証や設定に失敗した場合もアプライアンスは作成済みのため、stateに保存してから終了する
+	paramDiags := setDatabaseParameters(ctx, r.client, zone, db.ID, common.TmapToStrMap(plan.Parameters))
 	if _, err := plan.updateState(ctx, r.client, zone, db); err != nil {
+		resp.Diagnostics.Append(paramDiags...)
 		resp.Diagnostics.AddError("Create: Terraform Error", fmt.Sprintf("failed to update Database state: %s", err))
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(paramDiags...)
 }
 
 func (r *databaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -36,6 +36,7 @@ type databaseReadReplicaResource struct {
 	_ resource.Resource                = &databaseReadReplicaResource{}
 	_ resource.ResourceWithConfigure   = &databaseReadReplicaResource{}
 	_ resource.ResourceWithImportState = &databaseReadReplicaResource{}
+	_ resource.ResourceWithModifyPlan  = &databaseReadReplicaResource{}
 )
 
 func NewDatabaseReadReplicaResource() resource.Resource {
@@ -54,6 +55,10 @@ func (r *databaseReadReplicaResource) Configure(ctx context.Context, req resourc
 	r.client = apiclient
 }
 
+func (r *databaseReadReplicaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type databaseReadReplicaResourceModel struct {
 	common.SakuraBaseModel
 	IconID                   types.String                   `tfsdk:"icon_id"`
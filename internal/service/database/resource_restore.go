@@ -0,0 +1,166 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type databaseRestoreResource struct {
+	client *common.APIClient
+}
+
+var (
+	_ resource.Resource               = &databaseRestoreResource{}
+	_ resource.ResourceWithConfigure  = &databaseRestoreResource{}
+	_ resource.ResourceWithModifyPlan = &databaseRestoreResource{}
+)
+
+func NewDatabaseRestoreResource() resource.Resource {
+	return &databaseRestoreResource{}
+}
+
+func (r *databaseRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_database_restore"
+}
+
+func (r *databaseRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient
+}
+
+func (r *databaseRestoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
+type databaseRestoreResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	DatabaseID      types.String   `tfsdk:"database_id"`
+	Zone            types.String   `tfsdk:"zone"`
+	BackupCreatedAt types.String   `tfsdk:"backup_created_at"`
+	Triggers        types.Map      `tfsdk:"triggers"`
+	RestoredAt      types.String   `tfsdk:"restored_at"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *databaseRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("Database Restore"),
+			"database_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Database to restore",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of zone that the Database is in (e.g. `is1a`, `tk1a`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"backup_created_at": schema.StringAttribute{
+				Required:    true,
+				Description: "The time when the backup to restore was taken, in RFC3339 format. This can be found in `created_at` of `sakura_database_backups`",
+				Validators: []validator.String{
+					sacloudvalidator.StringFuncValidator(validateDatabaseBackupCreatedAt),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A map of arbitrary strings that, when changed, will restore the backup again.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"restored_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time when the Database was restored.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+		MarkdownDescription: "Restores a Database appliance from a backup when the resource is created or any of the arguments are changed.\n\n" +
+			"~> **Note:** The current data of the Database is overwritten by the backup. Destroying this resource does nothing. " +
+			"With Terraform 1.14 or later, consider the `sakura_database_restore` action instead.",
+	}
+}
+
+func (r *databaseRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan databaseRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout60min)
+	defer cancel()
+
+	zone := common.GetZone(plan.Zone, r.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := restoreDatabaseBackup(ctx, r.client, zone, common.ExpandSakuraCloudID(plan.DatabaseID), plan.BackupCreatedAt.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Create: API Error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	plan.Zone = types.StringValue(zone)
+	plan.RestoredAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *databaseRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// 復元は一度きりの操作のため、APIから読み込む状態はない
+}
+
+func (r *databaseRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// timeouts以外の引数は全てRequiresReplaceのため、planをそのまま保存する
+	var plan databaseRestoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *databaseRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func validateDatabaseBackupCreatedAt(value string) error {
+	_, err := time.Parse(time.RFC3339, value)
+	return err
+}
@@ -0,0 +1,44 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+// TestAccSakuraDatabaseRestore_basic はバックアップを持つ既存のデータベースを復元する。
+// SAKURA_DATABASE_RESTORE_IDに復元対象のデータベースのIDを指定すること
+func TestAccSakuraDatabaseRestore_basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, "SAKURA_DATABASE_RESTORE_ID")
+	resourceName := "sakura_database_restore.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDatabaseRestore_basic, os.Getenv("SAKURA_DATABASE_RESTORE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "backup_created_at", "data.sakura_database_backups.foobar", "backups.0.created_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "zone"),
+					resource.TestCheckResourceAttrSet(resourceName, "restored_at"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraDatabaseRestore_basic = `
+data "sakura_database_backups" "foobar" {
+  database_id = "{{ .arg0 }}"
+}
+
+resource "sakura_database_restore" "foobar" {
+  database_id       = data.sakura_database_backups.foobar.id
+  backup_created_at = data.sakura_database_backups.foobar.backups[0].created_at
+}`
@@ -7,6 +7,8 @@
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -106,6 +108,37 @@ func TestAccSakuraDatabase_basic(t *testing.T) {
 	})
 }
 
+func TestAccSakuraDatabase_invalidParameters(t *testing.T) {
+	resourceName := "sakura_database.foobar"
+	rand := test.RandomName()
+	password := test.RandomPassword()
+
+	var database iaas.Database
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraDatabaseDestroy,
+			test.CheckSakuraIconDestroy,
+			test.CheckSakuravSwitchDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDatabase_basic, rand, password),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraDatabaseExists(resourceName, &database),
+					resource.TestCheckResourceAttr(resourceName, "parameters.max_connections", "100"),
+				),
+			},
+			{
+				// パラメータのメタ情報は作成後のDatabaseから取得するため、範囲外の値は更新時のplanでエラーとなる
+				Config:      test.BuildConfigWithArgs(strings.Replace(testAccSakuraDatabase_basic, "max_connections = 100", "max_connections = 1", 1), rand, password),
+				ExpectError: regexp.MustCompile(`invalid value for "max_connections": must be between`),
+			},
+		},
+	})
+}
+
 func TestAccSakuraDatabase_basicWithWO(t *testing.T) {
 	resourceName := "sakura_database.foobar"
 	rand := test.RandomName()
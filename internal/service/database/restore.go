@@ -0,0 +1,86 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+)
+
+func flattenDatabaseBackupHistories(histories []*iaas.DatabaseBackupHistory) []databaseBackupHistoryModel {
+	results := make([]databaseBackupHistoryModel, 0, len(histories))
+	for _, h := range histories {
+		if h == nil {
+			continue
+		}
+		results = append(results, databaseBackupHistoryModel{
+			CreatedAt:    flattenDatabaseBackupTime(h.CreatedAt),
+			RecoveredAt:  flattenDatabaseBackupTime(h.RecoveredAt),
+			Availability: types.StringValue(h.Availability),
+			Size:         types.Int64Value(h.Size),
+		})
+	}
+	return results
+}
+
+func flattenDatabaseBackupTime(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// findDatabaseBackup はバックアップ履歴からcreatedAtと同じ時刻に取得されたバックアップを探す
+func findDatabaseBackup(histories []*iaas.DatabaseBackupHistory, createdAt string) (*iaas.DatabaseBackupHistory, error) {
+	t, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup time %q: %w", createdAt, err)
+	}
+	for _, h := range histories {
+		if h != nil && h.CreatedAt.Equal(t) {
+			return h, nil
+		}
+	}
+	return nil, fmt.Errorf("backup taken at %s is not found", createdAt)
+}
+
+// restoreDatabaseBackup はバックアップからデータベースを復元し、アプライアンスが再度起動するまで待つ。
+// iaas-api-goのDatabaseOpは復元APIを持たないため、APICallerで直接呼び出す
+func restoreDatabaseBackup(ctx context.Context, client *common.APIClient, zone string, id iaastypes.ID, createdAt string) error {
+	dbOp := iaas.NewDatabaseOp(client)
+	status, err := dbOp.Status(ctx, zone, id)
+	if err != nil {
+		return fmt.Errorf("failed to read status of Database[%s]: %w", id, err)
+	}
+	backup, err := findDatabaseBackup(status.Backups, createdAt)
+	if err != nil {
+		return fmt.Errorf("failed to find backup of Database[%s]: %w", id, err)
+	}
+
+	uri := fmt.Sprintf("%s/%s/api/cloud/1.1/appliance/%s/database/backup/history/%s",
+		iaas.SakuraCloudAPIRoot, zone, id, url.PathEscape(backup.CreatedAt.Format(time.RFC3339)))
+	if _, err := client.Do(ctx, http.MethodPut, uri, nil); err != nil {
+		return fmt.Errorf("failed to restore Database[%s] from backup taken at %s: %w", id, createdAt, err)
+	}
+
+	// HACK 復元APIは202(Accepted)を返し、少し遅れてアプライアンスが再起動する。
+	// 直後に状態を読み込むと再起動前の状態を取得してしまうため、作成時と同様に少しsleepしてから起動を待つ
+	time.Sleep(databaseWaitAfterCreateDuration)
+
+	waiter := iaas.WaiterForApplianceUp(func() (interface{}, error) {
+		return dbOp.Read(ctx, zone, id)
+	}, 100)
+	if _, err := waiter.WaitForState(ctx); err != nil {
+		return fmt.Errorf("failed to wait for Database[%s] to be up after restore: %w", id, err)
+	}
+	return nil
+}
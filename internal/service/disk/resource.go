@@ -41,6 +41,7 @@ type diskResource struct {
 	_ resource.Resource                = &diskResource{}
 	_ resource.ResourceWithConfigure   = &diskResource{}
 	_ resource.ResourceWithImportState = &diskResource{}
+	_ resource.ResourceWithModifyPlan  = &diskResource{}
 )
 
 func NewDiskResource() resource.Resource {
@@ -59,6 +60,10 @@ func (r *diskResource) Configure(ctx context.Context, req resource.ConfigureRequ
 	r.client = apiclient
 }
 
+func (r *diskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type diskResourceModel struct {
 	diskBaseModel
 	DistantFrom types.Set      `tfsdk:"distant_from"`
@@ -0,0 +1,95 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type dnsZoneFileDataSource struct {
+	client *common.APIClient
+}
+
+var (
+	_ datasource.DataSource              = &dnsZoneFileDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsZoneFileDataSource{}
+)
+
+func NewDNSZoneFileDataSource() datasource.DataSource {
+	return &dnsZoneFileDataSource{}
+}
+
+func (d *dnsZoneFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+func (d *dnsZoneFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.client = apiclient
+}
+
+type dnsZoneFileDataSourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	DNSID   types.String `tfsdk:"dns_id"`
+	Zone    types.String `tfsdk:"zone"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (d *dnsZoneFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the DNS",
+			},
+			"dns_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the DNS resource",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of managed domain",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "The records of the DNS rendered as a zone file in RFC 1035 format. The SOA record is not included",
+			},
+		},
+		MarkdownDescription: "Get the records of an existing DNS as a zone file.",
+	}
+}
+
+func (d *dnsZoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dnsZoneFileDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dns, err := iaas.NewDNSOp(d.client).Read(ctx, common.ExpandSakuraCloudID(data.DNSID))
+	if err != nil {
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read DNS[%s]: %s", data.DNSID.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(dns.ID.String())
+	data.Zone = types.StringValue(dns.DNSZone)
+	data.Content = types.StringValue(renderDNSZoneFile(dns))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
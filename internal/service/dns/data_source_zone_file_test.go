@@ -0,0 +1,62 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDNSZoneFileDataSource_basic(t *testing.T) {
+	resourceName := "data.sakura_dns_zone_file.foobar"
+	zone := fmt.Sprintf("%s.com", test.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             test.CheckSakuraDNSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDataSourceDNSZoneFile_basic, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sakura_dns.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "zone", zone),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(regexp.QuoteMeta("$ORIGIN "+zone+".\n"))),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`(?m)^www\t300\tIN\tA\t192\.168\.11\.1$`)),
+					resource.TestMatchResourceAttr(resourceName, "content", regexp.MustCompile(`(?m)^@\t3600\tIN\tTXT\t"v=spf1 -all"$`)),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraDataSourceDNSZoneFile_basic = `
+resource "sakura_dns" "foobar" {
+  zone = "{{ .arg0 }}"
+}
+resource "sakura_dns_record" "foobar1" {
+  dns_id = sakura_dns.foobar.id
+  name   = "www"
+  type   = "A"
+  value  = "192.168.11.1"
+  ttl    = 300
+}
+resource "sakura_dns_record" "foobar2" {
+  dns_id = sakura_dns.foobar.id
+  name   = "@"
+  type   = "TXT"
+  value  = "v=spf1 -all"
+
+  depends_on = [sakura_dns_record.foobar1]
+}
+
+data "sakura_dns_zone_file" "foobar" {
+  dns_id = sakura_dns.foobar.id
+
+  depends_on = [sakura_dns_record.foobar2]
+}`
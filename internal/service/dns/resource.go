@@ -150,6 +150,7 @@ func (r *dnsResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("failed to read updated DNS[%s]: %s", dns.ID.String(), err))
 		return
 	}
+	r.client.StoreDNS(updated)
 
 	plan.updateState(updated)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
@@ -175,11 +176,12 @@ func (r *dnsResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to delete DNS[%s]: %s", dns.ID.String(), err))
 		return
 	}
+	r.client.ForgetDNS(dns.ID)
 }
 
+// getDNS はAPIClientのキャッシュを通してDNSを読み込む。レコードを更新する場合は事前にForgetDNSでキャッシュを破棄すること
 func getDNS(ctx context.Context, client *common.APIClient, id iaastypes.ID, state *tfsdk.State, diags *diag.Diagnostics) *iaas.DNS {
-	dnsOp := iaas.NewDNSOp(client)
-	dns, err := dnsOp.Read(ctx, id)
+	dns, err := client.ReadDNS(ctx, id)
 	if err != nil {
 		if iaas.IsNotFoundError(err) {
 			state.RemoveResource(ctx)
@@ -235,11 +235,12 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	record, reqSetting := expandDNSRecordCreateRequest(&plan, dns)
-	_, err = dnsOp.UpdateSettings(ctx, dns.ID, reqSetting)
+	updated, err := dnsOp.UpdateSettings(ctx, dns.ID, reqSetting)
 	if err != nil {
 		resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to create Record for DNS[%s]: %s", dnsID, err))
 		return
 	}
+	r.client.StoreDNS(updated)
 
 	model := flattenDNSRecord(record)
 	plan.updateState(dnsRecordIDHash(dnsID, record), dnsID, &model)
@@ -314,16 +315,18 @@ func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 	common.SakuraMutexKV.Lock(dnsID)
 	defer common.SakuraMutexKV.Unlock(dnsID)
 
+	r.client.ForgetDNS(common.SakuraCloudID(dnsID))
 	dns := getDNS(ctx, r.client, common.SakuraCloudID(dnsID), &resp.State, &resp.Diagnostics)
 	if dns == nil {
 		return
 	}
 
-	_, err := dnsOp.UpdateSettings(ctx, common.SakuraCloudID(dnsID), expandDNSRecordDeleteRequest(&state, dns))
+	updated, err := dnsOp.UpdateSettings(ctx, common.SakuraCloudID(dnsID), expandDNSRecordDeleteRequest(&state, dns))
 	if err != nil {
 		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to delete DNSRecord[%s]: %s", dnsID, err))
 		return
 	}
+	r.client.StoreDNS(updated)
 }
 
 func (d *dnsRecordResourceModel) updateState(id, dnsID string, model *dnsRecordModel) {
@@ -0,0 +1,326 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type dnsRecordSetResource struct {
+	client *common.APIClient
+}
+
+var (
+	_ resource.Resource                = &dnsRecordSetResource{}
+	_ resource.ResourceWithConfigure   = &dnsRecordSetResource{}
+	_ resource.ResourceWithImportState = &dnsRecordSetResource{}
+)
+
+func NewDNSRecordSetResource() resource.Resource {
+	return &dnsRecordSetResource{}
+}
+
+func (r *dnsRecordSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_set"
+}
+
+func (r *dnsRecordSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient
+}
+
+type dnsRecordSetResourceModel struct {
+	ID       types.String   `tfsdk:"id"`
+	DNSID    types.String   `tfsdk:"dns_id"`
+	Name     types.String   `tfsdk:"name"`
+	Type     types.String   `tfsdk:"type"`
+	Values   types.Set      `tfsdk:"values"`
+	TTL      types.Int64    `tfsdk:"ttl"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dnsRecordSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("DNS Record Set"),
+			"dns_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the DNS resource",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the DNS Record Set. Use `@` for the zone apex",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: desc.Sprintf("The type of the DNS Record Set. This must be one of [%s]", iaastypes.DNSRecordTypeStrings),
+				Validators: []validator.String{
+					stringvalidator.OneOf(iaastypes.DNSRecordTypeStrings...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				MarkdownDescription: "The values of the DNS Record Set. " +
+					"For MX and SRV records, each value includes the priority, weight and port as in zone files (e.g. `10 mail.example.com.`, `1 2 5060 sip.example.com.`)",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of the TTL.",
+				Default:     int64default.StaticInt64(defaultTTL),
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+		MarkdownDescription: "Manages all records of a DNS with the same name and type. Changes of the values and the TTL are applied in place without deleting the records.",
+	}
+}
+
+func (r *dnsRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: dns_id/name/type
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Import: Invalid ID", fmt.Sprintf("Expected format: dns_id/name/type, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dns_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[2])...)
+}
+
+func (r *dnsRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsRecordSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	dns := r.updateRecordSet(ctx, &plan, true, "Create", &resp.Diagnostics)
+	if dns == nil {
+		return
+	}
+
+	plan.updateState(dns)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsRecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsRecordSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dns := getDNS(ctx, r.client, common.ExpandSakuraCloudID(state.DNSID), &resp.State, &resp.Diagnostics)
+	if dns == nil {
+		return
+	}
+
+	if len(filterDNSRecordSet(dns.Records, state.Name.ValueString(), state.Type.ValueString())) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.updateState(dns)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dnsRecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnsRecordSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	dns := r.updateRecordSet(ctx, &plan, false, "Update", &resp.Diagnostics)
+	if dns == nil {
+		return
+	}
+
+	plan.updateState(dns)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsRecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsRecordSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	dnsID := state.DNSID.ValueString()
+	common.SakuraMutexKV.Lock(dnsID)
+	defer common.SakuraMutexKV.Unlock(dnsID)
+
+	r.client.ForgetDNS(common.SakuraCloudID(dnsID))
+	dns := getDNS(ctx, r.client, common.SakuraCloudID(dnsID), &resp.State, &resp.Diagnostics)
+	if dns == nil {
+		return
+	}
+
+	records := mergeDNSRecordSet(dns.Records, state.Name.ValueString(), state.Type.ValueString(), nil)
+	updated, err := iaas.NewDNSOp(r.client).UpdateSettings(ctx, dns.ID, &iaas.DNSUpdateSettingsRequest{
+		Records:      records,
+		SettingsHash: dns.SettingsHash,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to delete DNS Record Set[%s]: %s", state.ID.ValueString(), err))
+		return
+	}
+	r.client.StoreDNS(updated)
+}
+
+// updateRecordSet はname/typeが一致するレコードをplanの内容で置き換える。1回のUpdateSettingsで置き換えるため、名前解決できない期間は発生しない
+func (r *dnsRecordSetResource) updateRecordSet(ctx context.Context, plan *dnsRecordSetResourceModel, create bool, op string, diags *diag.Diagnostics) *iaas.DNS {
+	dnsID := plan.DNSID.ValueString()
+	name := plan.Name.ValueString()
+	recordType := plan.Type.ValueString()
+
+	common.SakuraMutexKV.Lock(dnsID)
+	defer common.SakuraMutexKV.Unlock(dnsID)
+
+	dnsOp := iaas.NewDNSOp(r.client)
+	dns, err := dnsOp.Read(ctx, common.SakuraCloudID(dnsID))
+	if err != nil {
+		diags.AddError(op+": API Error", fmt.Sprintf("failed to read DNS[%s]: %s", dnsID, err))
+		return nil
+	}
+
+	if create && len(filterDNSRecordSet(dns.Records, name, recordType)) > 0 {
+		diags.AddError(op+": Conflict Error",
+			fmt.Sprintf("records with name %q and type %q already exist in DNS[%s]. Import them with the ID %q", name, recordType, dnsID, dnsRecordSetID(dnsID, name, recordType)))
+		return nil
+	}
+
+	records := mergeDNSRecordSet(dns.Records, name, recordType, expandDNSRecordSet(plan))
+	updated, err := dnsOp.UpdateSettings(ctx, dns.ID, &iaas.DNSUpdateSettingsRequest{
+		Records:      records,
+		SettingsHash: dns.SettingsHash,
+	})
+	if err != nil {
+		diags.AddError(op+": API Error", fmt.Sprintf("failed to update DNS Record Set[%s]: %s", dnsRecordSetID(dnsID, name, recordType), err))
+		return nil
+	}
+	r.client.StoreDNS(updated)
+	return updated
+}
+
+func (model *dnsRecordSetResourceModel) updateState(dns *iaas.DNS) {
+	dnsID := dns.ID.String()
+	name := model.Name.ValueString()
+	recordType := model.Type.ValueString()
+
+	records := filterDNSRecordSet(dns.Records, name, recordType)
+	values := make([]string, 0, len(records))
+	for _, r := range records {
+		values = append(values, r.RData)
+	}
+
+	model.ID = types.StringValue(dnsRecordSetID(dnsID, name, recordType))
+	model.DNSID = types.StringValue(dnsID)
+	model.Values = common.StringsToTset(values)
+	if len(records) > 0 {
+		model.TTL = types.Int64Value(int64(records[0].TTL))
+	}
+}
+
+func expandDNSRecordSet(model *dnsRecordSetResourceModel) []*iaas.DNSRecord {
+	var records []*iaas.DNSRecord
+	for _, value := range common.TsetToStrings(model.Values) {
+		records = append(records, &iaas.DNSRecord{
+			Name:  model.Name.ValueString(),
+			Type:  iaastypes.EDNSRecordType(model.Type.ValueString()),
+			RData: value,
+			TTL:   int(model.TTL.ValueInt64()),
+		})
+	}
+	return records
+}
+
+func filterDNSRecordSet(records []*iaas.DNSRecord, name, recordType string) []*iaas.DNSRecord {
+	var results []*iaas.DNSRecord
+	for _, r := range records {
+		if r.Name == name && string(r.Type) == recordType {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+// mergeDNSRecordSet はname/typeが一致するレコードをrecordSetで置き換える。
+// 他のレコードの並び順を変えないよう、置き換え後のレコードは元のレコードセットの先頭の位置に挿入する
+func mergeDNSRecordSet(records []*iaas.DNSRecord, name, recordType string, recordSet []*iaas.DNSRecord) []*iaas.DNSRecord {
+	results := make([]*iaas.DNSRecord, 0, len(records)+len(recordSet))
+	inserted := false
+	for _, r := range records {
+		if r.Name == name && string(r.Type) == recordType {
+			if !inserted {
+				results = append(results, recordSet...)
+				inserted = true
+			}
+			continue
+		}
+		results = append(results, r)
+	}
+	if !inserted {
+		results = append(results, recordSet...)
+	}
+	return results
+}
+
+func dnsRecordSetID(dnsID, name, recordType string) string {
+	return fmt.Sprintf("%s/%s/%s", dnsID, name, recordType)
+}
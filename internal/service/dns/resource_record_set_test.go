@@ -0,0 +1,103 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDNSRecordSet_basic(t *testing.T) {
+	resourceName := "sakura_dns_record_set.foobar"
+	zone := fmt.Sprintf("%s.com", test.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             test.CheckSakuraDNSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDNSRecordSet_basic, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "dns_id", "sakura_dns.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "www"),
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "192.168.0.1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "192.168.0.2"),
+					resource.TestCheckResourceAttr("sakura_dns_record_set.mx", "values.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sakura_dns_record_set.mx", "values.*", fmt.Sprintf("10 mail.%s.", zone)),
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDNSRecordSet_update, zone),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "192.168.0.2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "values.*", "192.168.0.3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+		},
+	})
+}
+
+var testAccSakuraDNSRecordSet_basic = `
+resource "sakura_dns" "foobar" {
+  zone = "{{ .arg0 }}"
+}
+
+resource "sakura_dns_record_set" "foobar" {
+  dns_id = sakura_dns.foobar.id
+  name   = "www"
+  type   = "A"
+  values = ["192.168.0.1", "192.168.0.2"]
+}
+
+resource "sakura_dns_record_set" "mx" {
+  dns_id = sakura_dns.foobar.id
+  name   = "@"
+  type   = "MX"
+  values = ["10 mail.{{ .arg0 }}."]
+}
+`
+
+var testAccSakuraDNSRecordSet_update = `
+resource "sakura_dns" "foobar" {
+  zone = "{{ .arg0 }}"
+}
+
+resource "sakura_dns_record_set" "foobar" {
+  dns_id = sakura_dns.foobar.id
+  name   = "www"
+  type   = "A"
+  values = ["192.168.0.2", "192.168.0.3"]
+  ttl    = 300
+}
+
+resource "sakura_dns_record_set" "mx" {
+  dns_id = sakura_dns.foobar.id
+  name   = "@"
+  type   = "MX"
+  values = ["10 mail.{{ .arg0 }}."]
+}
+`
@@ -0,0 +1,301 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type dnsZoneFileResource struct {
+	client *common.APIClient
+}
+
+var (
+	_ resource.Resource                = &dnsZoneFileResource{}
+	_ resource.ResourceWithConfigure   = &dnsZoneFileResource{}
+	_ resource.ResourceWithImportState = &dnsZoneFileResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsZoneFileResource{}
+)
+
+func NewDNSZoneFileResource() resource.Resource {
+	return &dnsZoneFileResource{}
+}
+
+func (r *dnsZoneFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+func (r *dnsZoneFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient
+}
+
+type dnsZoneFileResourceModel struct {
+	ID       types.String     `tfsdk:"id"`
+	DNSID    types.String     `tfsdk:"dns_id"`
+	Content  types.String     `tfsdk:"content"`
+	Records  []dnsRecordModel `tfsdk:"record"`
+	Timeouts timeouts.Value   `tfsdk:"timeouts"`
+}
+
+func (r *dnsZoneFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("DNS Zone File"),
+			"dns_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the DNS resource",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The zone file in RFC 1035 format. `$ORIGIN`, `$TTL` and relative names are supported. " +
+					"Names are relative to the zone of the DNS unless `$ORIGIN` is specified. " +
+					"SOA records and NS records at the zone apex are ignored because they are managed by the DNS",
+			},
+			"record": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The records of the zone",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of DNS Record",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: desc.Sprintf("The type of DNS Record. This will be one of [%s]", iaastypes.DNSRecordTypeStrings),
+						},
+						"value": schema.StringAttribute{
+							Computed:    true,
+							Description: "The value of the DNS Record",
+						},
+						"ttl": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of the TTL",
+						},
+						"priority": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The priority of target DNS Record",
+						},
+						"weight": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The weight of target DNS Record",
+						},
+						"port": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The number of port",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+		MarkdownDescription: "Manages the whole set of records of a DNS zone with a zone file.\n\n" +
+			"~> **Note:** Records not written in the zone file are removed. Don't use `sakura_dns_record` for the same DNS.",
+	}
+}
+
+func (r *dnsZoneFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("dns_id"), req, resp)
+}
+
+func (r *dnsZoneFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan *dnsZoneFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if plan == nil || plan.DNSID.IsUnknown() || plan.Content.IsUnknown() {
+		return
+	}
+
+	// レコードの差分をplanに表示するため、ゾーンファイルを解析した結果をrecordに設定する
+	dns, err := r.client.ReadDNS(ctx, common.ExpandSakuraCloudID(plan.DNSID))
+	if err != nil {
+		if iaas.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("ModifyPlan: API Error", fmt.Sprintf("failed to read DNS[%s]: %s", plan.DNSID.ValueString(), err))
+		return
+	}
+
+	records, err := expandDNSZoneFileRecords(plan.Content.ValueString(), dns.DNSZone)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid Zone File", err.Error())
+		return
+	}
+	plan.Records = flattenDNSZoneFileRecords(records)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func (r *dnsZoneFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnsZoneFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	dns := r.updateRecords(ctx, &plan, "Create", &resp.Diagnostics)
+	if dns == nil {
+		return
+	}
+
+	plan.updateState(dns)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsZoneFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnsZoneFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dns := getDNS(ctx, r.client, common.ExpandSakuraCloudID(state.DNSID), &resp.State, &resp.Diagnostics)
+	if dns == nil {
+		return
+	}
+
+	// インポート時はゾーンファイルが存在しないため、現在のレコードから生成する
+	if state.Content.IsNull() {
+		state.Content = types.StringValue(renderDNSZoneFile(dns))
+	}
+	state.updateState(dns)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dnsZoneFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dnsZoneFileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	dns := r.updateRecords(ctx, &plan, "Update", &resp.Diagnostics)
+	if dns == nil {
+		return
+	}
+
+	plan.updateState(dns)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnsZoneFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsZoneFileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	dnsID := state.DNSID.ValueString()
+	common.SakuraMutexKV.Lock(dnsID)
+	defer common.SakuraMutexKV.Unlock(dnsID)
+
+	r.client.ForgetDNS(common.SakuraCloudID(dnsID))
+	dns := getDNS(ctx, r.client, common.SakuraCloudID(dnsID), &resp.State, &resp.Diagnostics)
+	if dns == nil {
+		return
+	}
+
+	updated, err := iaas.NewDNSOp(r.client).UpdateSettings(ctx, dns.ID, &iaas.DNSUpdateSettingsRequest{
+		Records:      []*iaas.DNSRecord{},
+		SettingsHash: dns.SettingsHash,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to delete records of DNS[%s]: %s", dnsID, err))
+		return
+	}
+	r.client.StoreDNS(updated)
+}
+
+// updateRecords はゾーンファイルを解析し、DNSのレコードを置き換える
+func (r *dnsZoneFileResource) updateRecords(ctx context.Context, plan *dnsZoneFileResourceModel, op string, diags *diag.Diagnostics) *iaas.DNS {
+	dnsID := plan.DNSID.ValueString()
+	common.SakuraMutexKV.Lock(dnsID)
+	defer common.SakuraMutexKV.Unlock(dnsID)
+
+	dnsOp := iaas.NewDNSOp(r.client)
+	dns, err := dnsOp.Read(ctx, common.SakuraCloudID(dnsID))
+	if err != nil {
+		diags.AddError(op+": API Error", fmt.Sprintf("failed to read DNS[%s]: %s", dnsID, err))
+		return nil
+	}
+
+	records, err := expandDNSZoneFileRecords(plan.Content.ValueString(), dns.DNSZone)
+	if err != nil {
+		diags.AddError(op+": Zone File Error", fmt.Sprintf("failed to parse zone file for DNS[%s]: %s", dnsID, err))
+		return nil
+	}
+
+	updated, err := dnsOp.UpdateSettings(ctx, dns.ID, &iaas.DNSUpdateSettingsRequest{
+		Records:      records,
+		SettingsHash: dns.SettingsHash,
+	})
+	if err != nil {
+		diags.AddError(op+": API Error", fmt.Sprintf("failed to update records of DNS[%s]: %s", dnsID, err))
+		return nil
+	}
+	r.client.StoreDNS(updated)
+	return updated
+}
+
+func (model *dnsZoneFileResourceModel) updateState(dns *iaas.DNS) {
+	model.ID = types.StringValue(dns.ID.String())
+	model.DNSID = types.StringValue(dns.ID.String())
+	model.Records = flattenDNSZoneFileRecords(dns.Records)
+}
+
+func expandDNSZoneFileRecords(content, zone string) ([]*iaas.DNSRecord, error) {
+	models, err := parseDNSZoneFile(content, zone)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*iaas.DNSRecord, 0, len(models))
+	for _, m := range models {
+		records = append(records, expandDNSRecord(m))
+	}
+	return records, nil
+}
+
+// flattenDNSZoneFileRecords はレコードが存在しない場合も空のリストを返す
+func flattenDNSZoneFileRecords(records []*iaas.DNSRecord) []dnsRecordModel {
+	results := make([]dnsRecordModel, 0, len(records))
+	for _, record := range records {
+		results = append(results, flattenDNSRecord(record))
+	}
+	return results
+}
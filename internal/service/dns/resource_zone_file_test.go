@@ -0,0 +1,90 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDNSZoneFile_basic(t *testing.T) {
+	resourceName := "sakura_dns_zone_file.foobar"
+	zone := fmt.Sprintf("%s.com", test.RandomName())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             test.CheckSakuraDNSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDNSZoneFile_basic, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "dns_id", "sakura_dns.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "record.0.name", "www"),
+					resource.TestCheckResourceAttr(resourceName, "record.0.type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "record.0.value", "192.168.0.1"),
+					resource.TestCheckResourceAttr(resourceName, "record.0.ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "record.1.name", "@"),
+					resource.TestCheckResourceAttr(resourceName, "record.1.type", "MX"),
+					resource.TestCheckResourceAttr(resourceName, "record.1.value", fmt.Sprintf("mail.%s.", zone)),
+					resource.TestCheckResourceAttr(resourceName, "record.1.priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "record.2.name", "@"),
+					resource.TestCheckResourceAttr(resourceName, "record.2.type", "TXT"),
+					resource.TestCheckResourceAttr(resourceName, "record.2.value", "v=spf1 -all"),
+					resource.TestCheckResourceAttr(resourceName, "record.3.name", "_sip._tls"),
+					resource.TestCheckResourceAttr(resourceName, "record.3.type", "SRV"),
+					resource.TestCheckResourceAttr(resourceName, "record.3.value", "www.sakura.ne.jp."),
+					resource.TestCheckResourceAttr(resourceName, "record.3.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "record.3.weight", "2"),
+					resource.TestCheckResourceAttr(resourceName, "record.3.port", "3"),
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDNSZoneFile_update, zone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "record.0.name", "www2"),
+					resource.TestCheckResourceAttr(resourceName, "record.0.type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "record.0.value", "192.168.0.2"),
+					resource.TestCheckResourceAttr(resourceName, "record.0.ttl", "3600"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraDNSZoneFile_basic = `
+resource "sakura_dns" "foobar" {
+  zone = "{{ .arg0 }}"
+}
+
+resource "sakura_dns_zone_file" "foobar" {
+  dns_id  = sakura_dns.foobar.id
+  content = <<-EOT
+    $TTL 1h
+    www        300 IN A   192.168.0.1
+    @              IN MX  10 mail
+    @              IN TXT "v=spf1 -all"
+    _sip._tls      IN SRV 1 2 3 www.sakura.ne.jp.
+  EOT
+}
+`
+
+var testAccSakuraDNSZoneFile_update = `
+resource "sakura_dns" "foobar" {
+  zone = "{{ .arg0 }}"
+}
+
+resource "sakura_dns_zone_file" "foobar" {
+  dns_id  = sakura_dns.foobar.id
+  content = <<-EOT
+    $ORIGIN {{ .arg0 }}.
+    www2 IN A 192.168.0.2
+  EOT
+}
+`
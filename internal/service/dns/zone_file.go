@@ -0,0 +1,404 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+)
+
+// TXTレコードの1文字列あたりの最大長(バイト数)
+const zoneFileMaxTXTStringLength = 255
+
+type zoneFileToken struct {
+	value  string
+	quoted bool
+}
+
+type zoneFileEntry struct {
+	line int
+	// 行頭が空白の場合は直前のレコードのオーナー名を引き継ぐ
+	inheritOwner bool
+	tokens       []zoneFileToken
+}
+
+// parseDNSZoneFile はRFC 1035形式のゾーンファイルをレコードの一覧に変換する。
+// SOAレコードとゾーン頂点のNSレコードはDNSアプライアンス側で管理されるため無視する
+func parseDNSZoneFile(content, zone string) ([]*dnsRecordModel, error) {
+	entries, err := splitZoneFileEntries(content)
+	if err != nil {
+		return nil, err
+	}
+
+	zone = strings.ToLower(strings.TrimSuffix(zone, ".")) + "."
+	origin := zone
+	ttl := defaultTTL
+	owner := ""
+
+	var records []*dnsRecordModel
+	for _, e := range entries {
+		tokens := e.tokens
+		if strings.HasPrefix(tokens[0].value, "$") && !tokens[0].quoted {
+			switch strings.ToUpper(tokens[0].value) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", e.line)
+				}
+				origin = absoluteZoneFileName(strings.ToLower(tokens[1].value), origin)
+			case "$TTL":
+				v, ok := parseZoneFileTTL(valueOfZoneFileToken(tokens, 1))
+				if len(tokens) != 2 || !ok {
+					return nil, fmt.Errorf("line %d: $TTL requires a TTL value", e.line)
+				}
+				ttl = v
+			default:
+				return nil, fmt.Errorf("line %d: directive %s is not supported", e.line, tokens[0].value)
+			}
+			continue
+		}
+
+		if !e.inheritOwner {
+			owner = absoluteZoneFileName(strings.ToLower(tokens[0].value), origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: owner name is missing", e.line)
+		}
+
+		// TTLとクラスは省略可能で、順序も問わない
+		recordTTL := ttl
+		for range 2 {
+			if len(tokens) == 0 {
+				break
+			}
+			if v, ok := parseZoneFileTTL(tokens[0].value); ok {
+				recordTTL = v
+				tokens = tokens[1:]
+				continue
+			}
+			class := strings.ToUpper(tokens[0].value)
+			if class == "IN" {
+				tokens = tokens[1:]
+				continue
+			}
+			if class == "CH" || class == "HS" || class == "CS" {
+				return nil, fmt.Errorf("line %d: class %s is not supported", e.line, class)
+			}
+			break
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record type is missing", e.line)
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		rdata := tokens[1:]
+		if recordType == "SOA" || (recordType == "NS" && owner == zone) {
+			continue
+		}
+		if !slices.Contains(iaastypes.DNSRecordTypeStrings, recordType) {
+			return nil, fmt.Errorf("line %d: record type %s is not supported", e.line, recordType)
+		}
+
+		name, err := relativeZoneFileName(owner, zone)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", e.line, err)
+		}
+		record, err := expandZoneFileRecord(recordType, rdata, origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", e.line, err)
+		}
+		record.Name = types.StringValue(name)
+		record.Type = types.StringValue(recordType)
+		record.TTL = types.Int64Value(int64(recordTTL))
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func expandZoneFileRecord(recordType string, rdata []zoneFileToken, origin string) (*dnsRecordModel, error) {
+	record := &dnsRecordModel{
+		Priority: types.Int32Null(),
+		Weight:   types.Int32Null(),
+		Port:     types.Int32Null(),
+	}
+
+	requireArgs := func(n int) error {
+		if len(rdata) != n {
+			return fmt.Errorf("%s record requires %d values, got %d", recordType, n, len(rdata))
+		}
+		return nil
+	}
+	parseUint16 := func(s, name string) (types.Int32, error) {
+		v, err := strconv.ParseUint(s, 10, 16)
+		if err != nil {
+			return types.Int32Null(), fmt.Errorf("invalid %s of %s record: %s", name, recordType, s)
+		}
+		return types.Int32Value(int32(v)), nil
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if err := requireArgs(1); err != nil {
+			return nil, err
+		}
+		record.Value = types.StringValue(rdata[0].value)
+	case "CNAME", "NS", "PTR", "ALIAS":
+		if err := requireArgs(1); err != nil {
+			return nil, err
+		}
+		record.Value = types.StringValue(absoluteZoneFileName(rdata[0].value, origin))
+	case "MX":
+		if err := requireArgs(2); err != nil {
+			return nil, err
+		}
+		priority, err := parseUint16(rdata[0].value, "preference")
+		if err != nil {
+			return nil, err
+		}
+		record.Priority = priority
+		record.Value = types.StringValue(absoluteZoneFileName(rdata[1].value, origin))
+	case "SRV":
+		if err := requireArgs(4); err != nil {
+			return nil, err
+		}
+		var err error
+		if record.Priority, err = parseUint16(rdata[0].value, "priority"); err != nil {
+			return nil, err
+		}
+		if record.Weight, err = parseUint16(rdata[1].value, "weight"); err != nil {
+			return nil, err
+		}
+		if record.Port, err = parseUint16(rdata[2].value, "port"); err != nil {
+			return nil, err
+		}
+		record.Value = types.StringValue(absoluteZoneFileName(rdata[3].value, origin))
+	case "TXT":
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("TXT record requires at least one string")
+		}
+		// 複数の文字列は連結して1つの値として扱う
+		var b strings.Builder
+		for _, t := range rdata {
+			b.WriteString(t.value)
+		}
+		record.Value = types.StringValue(b.String())
+	case "CAA":
+		if err := requireArgs(3); err != nil {
+			return nil, err
+		}
+		record.Value = types.StringValue(fmt.Sprintf("%s %s %s", rdata[0].value, rdata[1].value, quoteZoneFileString(rdata[2].value)))
+	default:
+		// HTTPS, SVCBはそのまま値として扱う
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("%s record requires values", recordType)
+		}
+		values := make([]string, 0, len(rdata))
+		for _, t := range rdata {
+			if t.quoted {
+				values = append(values, quoteZoneFileString(t.value))
+			} else {
+				values = append(values, t.value)
+			}
+		}
+		record.Value = types.StringValue(strings.Join(values, " "))
+	}
+	return record, nil
+}
+
+// splitZoneFileEntries はゾーンファイルをコメントや括弧による複数行を考慮してエントリ毎のトークンに分割する
+func splitZoneFileEntries(content string) ([]zoneFileEntry, error) {
+	var entries []zoneFileEntry
+	var current zoneFileEntry
+	var token strings.Builder
+	inToken, inQuote, inComment, escaped := false, false, false, false
+	parenDepth, line := 0, 1
+	lineStart := true
+
+	flushToken := func(quoted bool) {
+		if inToken || quoted {
+			current.tokens = append(current.tokens, zoneFileToken{value: token.String(), quoted: quoted})
+		}
+		token.Reset()
+		inToken = false
+	}
+	flushEntry := func() {
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = zoneFileEntry{}
+	}
+
+	for _, c := range content {
+		if lineStart && parenDepth == 0 && len(current.tokens) == 0 {
+			current.line = line
+			current.inheritOwner = c == ' ' || c == '\t'
+		}
+		lineStart = false
+
+		switch {
+		case inComment:
+			if c == '\n' {
+				inComment = false
+			}
+		case escaped:
+			token.WriteRune(c)
+			escaped = false
+			continue
+		case c == '\\':
+			escaped = true
+			inToken = true
+			continue
+		case inQuote:
+			if c == '"' {
+				inQuote = false
+				flushToken(true)
+			} else {
+				if c == '\n' {
+					line++
+				}
+				token.WriteRune(c)
+			}
+			continue
+		case c == '"':
+			flushToken(false)
+			inQuote = true
+		case c == ';':
+			flushToken(false)
+			inComment = true
+		case c == '(':
+			flushToken(false)
+			parenDepth++
+		case c == ')':
+			flushToken(false)
+			if parenDepth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			parenDepth--
+		case c == ' ' || c == '\t' || c == '\r':
+			flushToken(false)
+		case c != '\n':
+			token.WriteRune(c)
+			inToken = true
+		}
+
+		if c == '\n' {
+			flushToken(false)
+			if parenDepth == 0 {
+				flushEntry()
+			}
+			line++
+			lineStart = true
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+	if parenDepth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+	flushToken(false)
+	flushEntry()
+	return entries, nil
+}
+
+// parseZoneFileTTL は秒数、もしくは1h30mのような単位付きのTTLを秒に変換する
+func parseZoneFileTTL(s string) (int, bool) {
+	units := map[rune]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, num, hasNum := 0, 0, false
+	if s == "" {
+		return 0, false
+	}
+	for _, c := range strings.ToLower(s) {
+		switch {
+		case c >= '0' && c <= '9':
+			num = num*10 + int(c-'0')
+			hasNum = true
+		case units[c] > 0 && hasNum:
+			total += num * units[c]
+			num, hasNum = 0, false
+		default:
+			return 0, false
+		}
+	}
+	return total + num, true
+}
+
+func valueOfZoneFileToken(tokens []zoneFileToken, i int) string {
+	if len(tokens) <= i {
+		return ""
+	}
+	return tokens[i].value
+}
+
+func absoluteZoneFileName(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "." + origin
+}
+
+// relativeZoneFileName はFQDNをゾーンからの相対名に変換する。ゾーン頂点は@とする
+func relativeZoneFileName(name, zone string) (string, error) {
+	if name == zone {
+		return "@", nil
+	}
+	if relative, ok := strings.CutSuffix(name, "."+zone); ok {
+		return relative, nil
+	}
+	return "", fmt.Errorf("%s is out of zone %s", name, zone)
+}
+
+func quoteZoneFileString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + r.Replace(s) + `"`
+}
+
+// renderDNSZoneFile はDNSゾーンのレコードをRFC 1035形式のゾーンファイルに変換する。
+// SOAレコードはDNSアプライアンス側で管理されるため出力しない
+func renderDNSZoneFile(dns *iaas.DNS) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", strings.TrimSuffix(dns.DNSZone, "."))
+	fmt.Fprintf(&b, "$TTL %d\n", defaultTTL)
+	for _, ns := range dns.DNSNameServers {
+		fmt.Fprintf(&b, "@\t%d\tIN\tNS\t%s\n", defaultTTL, strings.TrimSuffix(ns, ".")+".")
+	}
+	for _, r := range dns.Records {
+		if r == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", r.Name, r.TTL, r.Type, renderZoneFileRData(r))
+	}
+	return b.String()
+}
+
+func renderZoneFileRData(r *iaas.DNSRecord) string {
+	if r.Type != iaastypes.DNSRecordTypes.TXT {
+		return r.RData
+	}
+
+	// TXTレコードは255バイト以下の文字列に分割する。マルチバイト文字の途中では分割しない
+	var chunks []string
+	value := r.RData
+	for len(value) > zoneFileMaxTXTStringLength {
+		n := zoneFileMaxTXTStringLength
+		for n > 0 && !utf8.RuneStart(value[n]) {
+			n--
+		}
+		chunks = append(chunks, quoteZoneFileString(value[:n]))
+		value = value[n:]
+	}
+	chunks = append(chunks, quoteZoneFileString(value))
+	return strings.Join(chunks, " ")
+}
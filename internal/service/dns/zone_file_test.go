@@ -0,0 +1,129 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dns
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testZoneFile = `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA ns1.example.com. hostmaster.example.com. (
+            2026010101 ; serial
+            3600       ; refresh
+            600        ; retry
+            604800     ; expire
+            300 )      ; minimum
+@           NS  ns1.example.com.
+@       300 IN  A   192.0.2.1
+            IN  AAAA 2001:db8::1
+www     IN 600  CNAME @
+mail        A   192.0.2.2
+@           MX  10 mail
+@           TXT "v=spf1 include:_spf.example.com ~all"
+long        TXT "part1" "part2"
+quote       TXT "say \"hello\"; bye"
+@           CAA 0 issue "letsencrypt.org"
+_sip._tcp   SRV 1 2 5060 sip.example.net.
+$ORIGIN sub.example.com.
+host        A   192.0.2.3
+sub.example.com. NS ns.example.net.
+`
+
+func TestParseDNSZoneFile(t *testing.T) {
+	records, err := parseDNSZoneFile(testZoneFile, "example.com")
+	require.NoError(t, err)
+
+	var got []string
+	for _, r := range records {
+		rec := expandDNSRecord(r)
+		got = append(got, strings.Join([]string{rec.Name, rec.Type.String(), rec.RData, strconv.Itoa(rec.TTL)}, " "))
+	}
+	require.Equal(t, []string{
+		"@ A 192.0.2.1 300",
+		"@ AAAA 2001:db8::1 3600",
+		"www CNAME example.com. 600",
+		"mail A 192.0.2.2 3600",
+		"@ MX 10 mail.example.com. 3600",
+		"@ TXT v=spf1 include:_spf.example.com ~all 3600",
+		"long TXT part1part2 3600",
+		`quote TXT say "hello"; bye 3600`,
+		`@ CAA 0 issue "letsencrypt.org" 3600`,
+		"_sip._tcp SRV 1 2 5060 sip.example.net. 3600",
+		"host.sub A 192.0.2.3 3600",
+		"sub NS ns.example.net. 3600",
+	}, got)
+}
+
+func TestParseDNSZoneFile_errors(t *testing.T) {
+	cases := map[string]string{
+		"www IN A 192.0.2.1\nwww.example.org. IN A 192.0.2.1": "line 2: www.example.org. is out of zone example.com.",
+		"www IN CH A 192.0.2.1":                               "line 1: class CH is not supported",
+		"www IN DNAME example.net.":                           "line 1: record type DNAME is not supported",
+		"$INCLUDE other.zone":                                 "line 1: directive $INCLUDE is not supported",
+		"@ MX mail":                                           "line 1: MX record requires 2 values, got 1",
+		"@ MX 65536 mail":                                     "line 1: invalid preference of MX record: 65536",
+		"@ TXT \"unterminated":                                "line 1: unterminated quoted string",
+		"@ SOA ( ns1 hostmaster":                              "line 1: unbalanced parentheses",
+		"  IN A 192.0.2.1":                                    "line 1: owner name is missing",
+	}
+	for content, expected := range cases {
+		_, err := parseDNSZoneFile(content, "example.com")
+		require.EqualError(t, err, expected, content)
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	cases := map[string]int{"0": 0, "300": 300, "1h": 3600, "1h30m": 5400, "1W2D": 777600, "10s": 10}
+	for s, expected := range cases {
+		v, ok := parseZoneFileTTL(s)
+		require.True(t, ok, s)
+		require.Equal(t, expected, v, s)
+	}
+	for _, s := range []string{"", "IN", "h1", "1x"} {
+		_, ok := parseZoneFileTTL(s)
+		require.False(t, ok, s)
+	}
+}
+
+func TestRenderDNSZoneFile(t *testing.T) {
+	dns := &iaas.DNS{
+		DNSZone:        "example.com",
+		DNSNameServers: []string{"ns1.gslb1.sakura.ne.jp", "ns2.gslb1.sakura.ne.jp"},
+		Records: []*iaas.DNSRecord{
+			{Name: "www", Type: iaastypes.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 300},
+			{Name: "@", Type: iaastypes.DNSRecordTypes.MX, RData: "10 mail.example.com.", TTL: 3600},
+			{Name: "@", Type: iaastypes.DNSRecordTypes.TXT, RData: `say "hello"`, TTL: 3600},
+			{Name: "long", Type: iaastypes.DNSRecordTypes.TXT, RData: strings.Repeat("a", 300), TTL: 3600},
+			// 3バイトの文字は255バイト目をまたぐため、その手前で分割する
+			{Name: "ja", Type: iaastypes.DNSRecordTypes.TXT, RData: "a" + strings.Repeat("あ", 100), TTL: 3600},
+		},
+	}
+
+	content := renderDNSZoneFile(dns)
+	require.Equal(t, "$ORIGIN example.com.\n"+
+		"$TTL 3600\n"+
+		"@\t3600\tIN\tNS\tns1.gslb1.sakura.ne.jp.\n"+
+		"@\t3600\tIN\tNS\tns2.gslb1.sakura.ne.jp.\n"+
+		"www\t300\tIN\tA\t192.0.2.1\n"+
+		"@\t3600\tIN\tMX\t10 mail.example.com.\n"+
+		"@\t3600\tIN\tTXT\t\"say \\\"hello\\\"\"\n"+
+		"long\t3600\tIN\tTXT\t\""+strings.Repeat("a", 255)+"\" \""+strings.Repeat("a", 45)+"\"\n"+
+		"ja\t3600\tIN\tTXT\t\"a"+strings.Repeat("あ", 84)+"\" \""+strings.Repeat("あ", 16)+"\"\n", content)
+
+	// 出力したゾーンファイルを再度読み込むと、ゾーン頂点のNSレコードを除いて同じレコードになる
+	records, err := parseDNSZoneFile(content, dns.DNSZone)
+	require.NoError(t, err)
+	require.Len(t, records, len(dns.Records))
+	for i, r := range records {
+		require.True(t, IsSameDNSRecord(dns.Records[i], expandDNSRecord(r)), dns.Records[i].Name)
+	}
+}
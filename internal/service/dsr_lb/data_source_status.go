@@ -0,0 +1,196 @@
+// Copyright 2016-2026 terraform-provider-sakura authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dsr_lb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	iaas "github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type dsrLBStatusDataSource struct {
+	client *common.APIClient
+}
+
+func NewDSRLBStatusDataSource() datasource.DataSource {
+	return &dsrLBStatusDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &dsrLBStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &dsrLBStatusDataSource{}
+)
+
+func (d *dsrLBStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dsr_lb_status"
+}
+
+func (d *dsrLBStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.client = apiclient
+}
+
+type dsrLBStatusDataSourceModel struct {
+	ID      types.String          `tfsdk:"id"`
+	DSRLBID types.String          `tfsdk:"dsr_lb_id"`
+	Zone    types.String          `tfsdk:"zone"`
+	Healthy types.Bool            `tfsdk:"healthy"`
+	VIP     []dsrLBVIPStatusModel `tfsdk:"vip"`
+}
+
+type dsrLBVIPStatusModel struct {
+	VIP    types.String             `tfsdk:"vip"`
+	Port   types.Int32              `tfsdk:"port"`
+	CPS    types.Int64              `tfsdk:"cps"`
+	Server []dsrLBServerStatusModel `tfsdk:"server"`
+}
+
+type dsrLBServerStatusModel struct {
+	IPAddress         types.String `tfsdk:"ip_address"`
+	Port              types.Int32  `tfsdk:"port"`
+	Status            types.String `tfsdk:"status"`
+	ActiveConnections types.Int64  `tfsdk:"active_connections"`
+	CPS               types.Int64  `tfsdk:"cps"`
+}
+
+func (d *dsrLBStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the status of a DSR LB and its real servers",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the DSR LB",
+			},
+			"dsr_lb_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the DSR LB",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+			},
+			"zone": common.SchemaDataSourceZone("DSR LB"),
+			"healthy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "The flag that all real servers of all VIPs are up. This is false if the DSR LB has no servers",
+			},
+			"vip": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The status of each VIP",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"vip": schema.StringAttribute{
+							Computed:    true,
+							Description: "The virtual IP address",
+						},
+						"port": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The target port number for load-balancing",
+						},
+						"cps": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of connections per second to the VIP",
+						},
+						"server": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The status of each real server",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"ip_address": schema.StringAttribute{
+										Computed:    true,
+										Description: "The IP address of the real server",
+									},
+									"port": schema.Int32Attribute{
+										Computed:    true,
+										Description: "The port number of the real server",
+									},
+									"status": schema.StringAttribute{
+										Computed:    true,
+										Description: "The health status of the real server (e.g. `up`, `down`)",
+									},
+									"active_connections": schema.Int64Attribute{
+										Computed:    true,
+										Description: "The number of active connections to the real server",
+									},
+									"cps": schema.Int64Attribute{
+										Computed:    true,
+										Description: "The number of connections per second to the real server",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dsrLBStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dsrLBStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := common.GetZone(data.Zone, d.client, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.DSRLBID.ValueString()
+	res, err := iaas.NewLoadBalancerOp(d.client).Status(ctx, zone, common.SakuraCloudID(id))
+	if err != nil {
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read status of DSR LB[%s]: %s", id, err))
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.Zone = types.StringValue(zone)
+	data.VIP, data.Healthy = flattenDSRLBStatus(res.Status)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenDSRLBStatus はVIP毎のステータスと、全ての実サーバがupかどうかを返す
+func flattenDSRLBStatus(statuses []*iaas.LoadBalancerStatus) ([]dsrLBVIPStatusModel, types.Bool) {
+	vips := make([]dsrLBVIPStatusModel, 0, len(statuses))
+	healthy, found := true, false
+	for _, st := range statuses {
+		if st == nil {
+			continue
+		}
+		servers := make([]dsrLBServerStatusModel, 0, len(st.Servers))
+		for _, s := range st.Servers {
+			if s == nil {
+				continue
+			}
+			servers = append(servers, dsrLBServerStatusModel{
+				IPAddress:         types.StringValue(s.IPAddress),
+				Port:              types.Int32Value(int32(s.Port.Int())),
+				Status:            types.StringValue(string(s.Status)),
+				ActiveConnections: types.Int64Value(s.ActiveConn.Int64()),
+				CPS:               types.Int64Value(s.CPS.Int64()),
+			})
+			healthy = healthy && s.Status.IsUp()
+			found = true
+		}
+		vips = append(vips, dsrLBVIPStatusModel{
+			VIP:    types.StringValue(st.VirtualIPAddress),
+			Port:   types.Int32Value(int32(st.Port.Int())),
+			CPS:    types.Int64Value(st.CPS.Int64()),
+			Server: servers,
+		})
+	}
+	return vips, types.BoolValue(healthy && found)
+}
@@ -0,0 +1,74 @@
+// Copyright 2016-2026 terraform-provider-sakura authors
+// SPDX-License-Identifier: Apache-2.0
+
+package dsr_lb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDataSourceDSRLBStatus_basic(t *testing.T) {
+	if !test.IsFakeModeEnabled() {
+		test.SkipIfEnvIsNotSet(t, "SAKURA_ENABLE_DSRLB_TEST")
+	}
+
+	resourceName := "data.sakura_dsr_lb_status.foobar"
+	rand := test.RandomName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDataSourceDSRLBStatus_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sakura_dsr_lb.foobar", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "zone", "sakura_dsr_lb.foobar", "zone"),
+					resource.TestCheckResourceAttr(resourceName, "vip.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vip.0.vip", "192.168.11.201"),
+					resource.TestCheckResourceAttr(resourceName, "vip.0.port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "vip.0.server.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vip.0.server.0.ip_address", "192.168.11.51"),
+					resource.TestCheckResourceAttrSet(resourceName, "vip.0.server.0.status"),
+					// 実サーバが存在しないためヘルスチェックは失敗する
+					resource.TestCheckResourceAttr(resourceName, "healthy", "false"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraDataSourceDSRLBStatus_basic = `
+resource sakura_vswitch "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_dsr_lb" "foobar" {
+  network_interface = {
+    vswitch_id   = sakura_vswitch.foobar.id
+    vrid         = 1
+    ip_addresses = ["192.168.11.101"]
+    netmask      = 24
+    gateway      = "192.168.11.1"
+  }
+
+  name = "{{ .arg0 }}"
+
+  vip = [{
+    vip  = "192.168.11.201"
+    port = 80
+    server = [{
+      ip_address = "192.168.11.51"
+      protocol   = "http"
+      path       = "/ping.html"
+      status     = 200
+    }]
+  }]
+}
+
+data "sakura_dsr_lb_status" "foobar" {
+  dsr_lb_id = sakura_dsr_lb.foobar.id
+  zone      = sakura_dsr_lb.foobar.zone
+}`
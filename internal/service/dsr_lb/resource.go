@@ -41,6 +41,7 @@ type dsrLBResource struct {
 	_ resource.Resource                = &dsrLBResource{}
 	_ resource.ResourceWithConfigure   = &dsrLBResource{}
 	_ resource.ResourceWithImportState = &dsrLBResource{}
+	_ resource.ResourceWithModifyPlan  = &dsrLBResource{}
 )
 
 func NewDSRLBResource() resource.Resource {
@@ -59,6 +60,10 @@ func (r *dsrLBResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = apiclient
 }
 
+func (r *dsrLBResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type dsrLBResourceModel struct {
 	dsrLBBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
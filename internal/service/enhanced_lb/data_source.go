@@ -217,6 +217,10 @@ func (d *enhancedLBDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 						Computed:    true,
 						Description: "The subject alternative names of the certificate",
 					},
+					"expires_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The expiration date of the certificate in RFC3339 format",
+					},
 					"additional_certificate": schema.ListNestedAttribute{
 						Computed: true,
 						NestedObject: schema.NestedAttributeObject{
@@ -234,6 +238,10 @@ func (d *enhancedLBDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 									Sensitive:   true,
 									Description: "The private key for a server",
 								},
+								"expires_at": schema.StringAttribute{
+									Computed:    true,
+									Description: "The expiration date of the certificate in RFC3339 format",
+								},
 							},
 						},
 					},
@@ -0,0 +1,161 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package enhanced_lb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type enhancedLBHealthDataSource struct {
+	client *common.APIClient
+}
+
+var (
+	_ datasource.DataSource              = &enhancedLBHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &enhancedLBHealthDataSource{}
+)
+
+func NewEnhancedLBHealthDataSource() datasource.DataSource {
+	return &enhancedLBHealthDataSource{}
+}
+
+func (d *enhancedLBHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enhanced_lb_health"
+}
+
+func (d *enhancedLBHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.client = apiclient
+}
+
+type enhancedLBHealthDataSourceModel struct {
+	ID                types.String                  `tfsdk:"id"`
+	EnhancedLBID      types.String                  `tfsdk:"enhanced_lb_id"`
+	ActiveConnections types.Int64                   `tfsdk:"active_connections"`
+	CPS               types.Float64                 `tfsdk:"cps"`
+	CurrentVIP        types.String                  `tfsdk:"current_vip"`
+	Healthy           types.Bool                    `tfsdk:"healthy"`
+	Server            []enhancedLBServerHealthModel `tfsdk:"server"`
+}
+
+type enhancedLBServerHealthModel struct {
+	IPAddress         types.String  `tfsdk:"ip_address"`
+	Port              types.Int32   `tfsdk:"port"`
+	Status            types.String  `tfsdk:"status"`
+	ActiveConnections types.Int64   `tfsdk:"active_connections"`
+	CPS               types.Float64 `tfsdk:"cps"`
+}
+
+func (d *enhancedLBHealthDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the health status of an Enhanced LB and its destination servers",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the Enhanced LB",
+			},
+			"enhanced_lb_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Enhanced LB",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+			},
+			"active_connections": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The number of active connections of the Enhanced LB",
+			},
+			"cps": schema.Float64Attribute{
+				Computed:    true,
+				Description: "The number of connections per second of the Enhanced LB",
+			},
+			"current_vip": schema.StringAttribute{
+				Computed:    true,
+				Description: "The virtual IP address currently assigned to the Enhanced LB",
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:    true,
+				Description: "The flag that all destination servers are up. This is false if the Enhanced LB has no servers",
+			},
+			"server": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The health status of each destination server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip_address": schema.StringAttribute{
+							Computed:    true,
+							Description: "The IP address of the destination server",
+						},
+						"port": schema.Int32Attribute{
+							Computed:    true,
+							Description: "The port number of the destination server",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The health status of the destination server (e.g. `up`, `down`)",
+						},
+						"active_connections": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of active connections to the destination server",
+						},
+						"cps": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The number of connections per second to the destination server",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *enhancedLBHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data enhancedLBHealthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elbID := data.EnhancedLBID.ValueString()
+	health, err := iaas.NewProxyLBOp(d.client).HealthStatus(ctx, common.SakuraCloudID(elbID))
+	if err != nil {
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read health status of Enhanced LB[%s]: %s", elbID, err))
+		return
+	}
+
+	data.ID = types.StringValue(elbID)
+	data.ActiveConnections = types.Int64Value(int64(health.ActiveConn))
+	data.CPS = types.Float64Value(health.CPS)
+	data.CurrentVIP = types.StringValue(health.CurrentVIP)
+	data.Server = make([]enhancedLBServerHealthModel, 0, len(health.Servers))
+	healthy := len(health.Servers) > 0
+	for _, s := range health.Servers {
+		if s == nil {
+			continue
+		}
+		data.Server = append(data.Server, enhancedLBServerHealthModel{
+			IPAddress:         types.StringValue(s.IPAddress),
+			Port:              types.Int32Value(int32(s.Port.Int())),
+			Status:            types.StringValue(string(s.Status)),
+			ActiveConnections: types.Int64Value(s.ActiveConn.Int64()),
+			CPS:               types.Float64Value(s.CPS.Float64()),
+		})
+		healthy = healthy && s.Status.IsUp()
+	}
+	data.Healthy = types.BoolValue(healthy)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
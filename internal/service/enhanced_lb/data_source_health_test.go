@@ -0,0 +1,69 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package enhanced_lb_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDataSourceEnhancedLBHealth_basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envEnhancedLBRealServerIP0, envEnhancedLBRealServerIP1)
+
+	rand := test.RandomName()
+	ip0 := os.Getenv(envEnhancedLBRealServerIP0)
+	ip1 := os.Getenv(envEnhancedLBRealServerIP1)
+	resourceName := "data.sakura_enhanced_lb_health.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraEnhancedLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraDataSourceEnhancedLBHealth_basic, rand, ip0, ip1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "sakura_enhanced_lb.foobar", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "current_vip"),
+					resource.TestCheckResourceAttrSet(resourceName, "active_connections"),
+					resource.TestCheckResourceAttrSet(resourceName, "cps"),
+					resource.TestCheckResourceAttr(resourceName, "server.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "server.0.status"),
+					resource.TestCheckResourceAttrSet(resourceName, "healthy"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraDataSourceEnhancedLBHealth_basic = `
+resource "sakura_enhanced_lb" "foobar" {
+  name = "{{ .arg0 }}"
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "http"
+    port       = 80
+  }]
+  server = [
+    {
+      ip_address = "{{ .arg1 }}"
+      port       = 80
+    },
+    {
+      ip_address = "{{ .arg2 }}"
+      port       = 80
+    },
+  ]
+}
+
+data "sakura_enhanced_lb_health" "foobar" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+}
+`
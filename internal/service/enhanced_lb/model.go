@@ -6,6 +6,7 @@
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -112,6 +113,7 @@ type enhancedLBCertificateModel struct {
 	PrivateKey            types.String                           `tfsdk:"private_key"`
 	CommonName            types.String                           `tfsdk:"common_name"`
 	SubjectAltNames       types.String                           `tfsdk:"subject_alt_names"`
+	ExpiresAt             types.String                           `tfsdk:"expires_at"`
 	AdditionalCertificate []enhancedLBAdditionalCertificateModel `tfsdk:"additional_certificate"`
 }
 
@@ -122,6 +124,7 @@ func (m enhancedLBCertificateModel) AttributeTypes() map[string]attr.Type {
 		"private_key":            types.StringType,
 		"common_name":            types.StringType,
 		"subject_alt_names":      types.StringType,
+		"expires_at":             types.StringType,
 		"additional_certificate": types.ListType{ElemType: types.ObjectType{AttrTypes: enhancedLBAdditionalCertificateModel{}.AttributeTypes()}},
 	}
 }
@@ -130,6 +133,7 @@ type enhancedLBAdditionalCertificateModel struct {
 	ServerCert       types.String `tfsdk:"server_cert"`
 	IntermediateCert types.String `tfsdk:"intermediate_cert"`
 	PrivateKey       types.String `tfsdk:"private_key"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
 }
 
 func (m enhancedLBAdditionalCertificateModel) AttributeTypes() map[string]attr.Type {
@@ -137,6 +141,7 @@ func (m enhancedLBAdditionalCertificateModel) AttributeTypes() map[string]attr.T
 		"server_cert":       types.StringType,
 		"intermediate_cert": types.StringType,
 		"private_key":       types.StringType,
+		"expires_at":        types.StringType,
 	}
 }
 
@@ -258,40 +263,48 @@ func flattenEnhancedLBSorryServer(elb *iaas.ProxyLB) *enhancedLBSorryServerModel
 func flattenEnhancedLBServers(elb *iaas.ProxyLB) []enhancedLBServerModel {
 	var results []enhancedLBServerModel
 	for _, server := range elb.Servers {
-		results = append(results, enhancedLBServerModel{
-			IPAddress:  types.StringValue(server.IPAddress),
-			Port:       types.Int32Value(int32(server.Port)),
-			Enabled:    types.BoolValue(server.Enabled),
-			Group:      types.StringValue(server.ServerGroup), // TODO: Only Optional?
-			TLSEnabled: types.BoolValue(server.ProxySSL),
-		})
+		results = append(results, flattenEnhancedLBServer(server))
 	}
 	return results
 }
 
+func flattenEnhancedLBServer(server *iaas.ProxyLBServer) enhancedLBServerModel {
+	return enhancedLBServerModel{
+		IPAddress:  types.StringValue(server.IPAddress),
+		Port:       types.Int32Value(int32(server.Port)),
+		Enabled:    types.BoolValue(server.Enabled),
+		Group:      types.StringValue(server.ServerGroup), // TODO: Only Optional?
+		TLSEnabled: types.BoolValue(server.ProxySSL),
+	}
+}
+
 func flattenEnhancedLBRules(elb *iaas.ProxyLB) []enhancedLBRuleModel {
 	var results []enhancedLBRuleModel
 	for _, rule := range elb.Rules {
-		results = append(results, enhancedLBRuleModel{
-			Host:                         types.StringValue(rule.Host),
-			Path:                         types.StringValue(rule.Path),
-			SourceIPs:                    types.StringValue(rule.SourceIPs),
-			RequestHeaderName:            types.StringValue(rule.RequestHeaderName),
-			RequestHeaderValue:           types.StringValue(rule.RequestHeaderValue),
-			RequestHeaderValueIgnoreCase: types.BoolValue(rule.RequestHeaderValueIgnoreCase),
-			RequestHeaderValueNotMatch:   types.BoolValue(rule.RequestHeaderValueNotMatch),
-			Group:                        types.StringValue(rule.ServerGroup),
-			Action:                       types.StringValue(rule.Action.String()),
-			RedirectLocation:             types.StringValue(rule.RedirectLocation),
-			RedirectStatusCode:           types.StringValue(rule.RedirectStatusCode.String()),
-			FixedStatusCode:              types.StringValue(rule.FixedStatusCode.String()),
-			FixedContentType:             types.StringValue(rule.FixedContentType.String()),
-			FixedMessageBody:             types.StringValue(rule.FixedMessageBody),
-		})
+		results = append(results, flattenEnhancedLBRule(rule))
 	}
 	return results
 }
 
+func flattenEnhancedLBRule(rule *iaas.ProxyLBRule) enhancedLBRuleModel {
+	return enhancedLBRuleModel{
+		Host:                         types.StringValue(rule.Host),
+		Path:                         types.StringValue(rule.Path),
+		SourceIPs:                    types.StringValue(rule.SourceIPs),
+		RequestHeaderName:            types.StringValue(rule.RequestHeaderName),
+		RequestHeaderValue:           types.StringValue(rule.RequestHeaderValue),
+		RequestHeaderValueIgnoreCase: types.BoolValue(rule.RequestHeaderValueIgnoreCase),
+		RequestHeaderValueNotMatch:   types.BoolValue(rule.RequestHeaderValueNotMatch),
+		Group:                        types.StringValue(rule.ServerGroup),
+		Action:                       types.StringValue(rule.Action.String()),
+		RedirectLocation:             types.StringValue(rule.RedirectLocation),
+		RedirectStatusCode:           types.StringValue(rule.RedirectStatusCode.String()),
+		FixedStatusCode:              types.StringValue(rule.FixedStatusCode.String()),
+		FixedContentType:             types.StringValue(rule.FixedContentType.String()),
+		FixedMessageBody:             types.StringValue(rule.FixedMessageBody),
+	}
+}
+
 func flattenEnhancedLBCerts(certs *iaas.ProxyLBCertificates, modelObj types.Object) types.Object {
 	v := types.ObjectNull(enhancedLBCertificateModel{}.AttributeTypes())
 	if certs == nil {
@@ -320,6 +333,7 @@ func flattenEnhancedLBCerts(certs *iaas.ProxyLBCertificates, modelObj types.Obje
 		}
 		elbCert.CommonName = types.StringValue(certs.PrimaryCert.CertificateCommonName)
 		elbCert.SubjectAltNames = types.StringValue(certs.PrimaryCert.CertificateAltNames)
+		elbCert.ExpiresAt = flattenEnhancedLBCertificateEndDate(certs.PrimaryCert.CertificateEndDate)
 	}
 	if len(certs.AdditionalCerts) > 0 {
 		var additionalCerts []enhancedLBAdditionalCertificateModel
@@ -338,6 +352,7 @@ func flattenEnhancedLBCerts(certs *iaas.ProxyLBCertificates, modelObj types.Obje
 				ServerCert:       types.StringValue(ac.ServerCertificate),
 				IntermediateCert: types.StringValue(ac.IntermediateCertificate),
 				PrivateKey:       pKey,
+				ExpiresAt:        flattenEnhancedLBCertificateEndDate(ac.CertificateEndDate),
 			})
 		}
 		elbCert.AdditionalCertificate = additionalCerts
@@ -350,6 +365,14 @@ func flattenEnhancedLBCerts(certs *iaas.ProxyLBCertificates, modelObj types.Obje
 	return value
 }
 
+// flattenEnhancedLBCertificateEndDate は証明書の有効期限をRFC3339形式で返す。APIが有効期限を返さない場合はnullとする
+func flattenEnhancedLBCertificateEndDate(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
 func flattenEnhancedLBStickySession(elb *iaas.ProxyLB) bool {
 	if elb.StickySession != nil {
 		return elb.StickySession.Enabled
@@ -13,6 +13,7 @@
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,9 +27,11 @@
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/sacloud/iaas-api-go"
 	iaastypes "github.com/sacloud/iaas-api-go/types"
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
 	"github.com/sacloud/terraform-provider-sakura/internal/desc"
 	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
 )
@@ -38,9 +41,10 @@ type enhancedLBResource struct {
 }
 
 var (
-	_ resource.Resource                = &enhancedLBResource{}
-	_ resource.ResourceWithConfigure   = &enhancedLBResource{}
-	_ resource.ResourceWithImportState = &enhancedLBResource{}
+	_ resource.Resource                   = &enhancedLBResource{}
+	_ resource.ResourceWithConfigure      = &enhancedLBResource{}
+	_ resource.ResourceWithImportState    = &enhancedLBResource{}
+	_ resource.ResourceWithValidateConfig = &enhancedLBResource{}
 )
 
 func NewEnhancedLBResource() resource.Resource {
@@ -61,7 +65,24 @@ func (r *enhancedLBResource) Configure(ctx context.Context, req resource.Configu
 
 type enhancedLBResourceModel struct {
 	enhancedLBBaseModel
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	ManageServers      types.Bool     `tfsdk:"manage_servers"`
+	ManageRules        types.Bool     `tfsdk:"manage_rules"`
+	ManageCertificates types.Bool     `tfsdk:"manage_certificates"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// enhancedLBResourceCertificateModel はリソースのcertificateの値。データソースと共通の属性に参照先(source)を加えたもの
+type enhancedLBResourceCertificateModel struct {
+	enhancedLBCertificateModel
+	Source        *enhancedLBCertificateSourceModel `tfsdk:"source"`
+	SourceVersion types.String                      `tfsdk:"source_version"`
+}
+
+func (m enhancedLBResourceCertificateModel) AttributeTypes() map[string]attr.Type {
+	attrTypes := m.enhancedLBCertificateModel.AttributeTypes()
+	attrTypes["source"] = types.ObjectType{AttrTypes: enhancedLBCertificateSourceModel{}.AttributeTypes()}
+	attrTypes["source_version"] = types.StringType
+	return attrTypes
 }
 
 func (r *enhancedLBResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -295,24 +316,45 @@ func (r *enhancedLBResource) Schema(ctx context.Context, _ resource.SchemaReques
 			},
 			// WriteOnlyにしたいがするとComputedを外す必要があり、互換性問題が起きる可能性が高いので現状はSensitiveのみを維持
 			"certificate": schema.SingleNestedAttribute{
-				Optional: true,
-				Computed: true,
+				Optional:    true,
+				Computed:    true,
+				Description: "The certificates of the Enhanced LB. If omitted, all certificates are removed unless `manage_certificates` is `false`",
 				Attributes: map[string]schema.Attribute{
 					"server_cert": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
 						Description: "The certificate for a server",
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("source")),
+						},
 					},
 					"intermediate_cert": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
 						Description: "The intermediate certificate for a server",
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("source")),
+						},
 					},
 					"private_key": schema.StringAttribute{
 						Optional:    true,
 						Computed:    true,
 						Sensitive:   true,
 						Description: "The private key for a server",
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("source")),
+						},
+					},
+					"source": schema.SingleNestedAttribute{
+						Optional: true,
+						MarkdownDescription: "The external source of the primary certificate. The source must contain a PEM bundle of the server certificate, the intermediate certificates and the unencrypted private key. " +
+							"The first certificate in the bundle is used as the server certificate. " +
+							"When the source is updated, the change is detected on plan and the certificate is set again",
+						Attributes: schemaEnhancedLBCertificateSourceAttributes(),
+					},
+					"source_version": schema.StringAttribute{
+						Computed:    true,
+						Description: "The version of `source` that the certificate was loaded from. The version of the secret for Secret Manager, or the ETag of the object for Object Storage",
 					},
 					"common_name": schema.StringAttribute{
 						Computed:    true,
@@ -322,6 +364,10 @@ func (r *enhancedLBResource) Schema(ctx context.Context, _ resource.SchemaReques
 						Computed:    true,
 						Description: "The subject alternative names of the certificate",
 					},
+					"expires_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The expiration date of the certificate in RFC3339 format",
+					},
 					"additional_certificate": schema.ListNestedAttribute{
 						Optional: true,
 						NestedObject: schema.NestedAttributeObject{
@@ -339,13 +385,18 @@ func (r *enhancedLBResource) Schema(ctx context.Context, _ resource.SchemaReques
 									Sensitive:   true,
 									Description: "The private key for a server",
 								},
+								"expires_at": schema.StringAttribute{
+									Computed:    true,
+									Description: "The expiration date of the certificate in RFC3339 format",
+								},
 							},
 						},
 					},
 				},
 			},
 			"server": schema.ListNestedAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "The destination servers. If omitted, all servers are removed unless `manage_servers` is `false`",
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(40),
 				},
@@ -389,7 +440,8 @@ func (r *enhancedLBResource) Schema(ctx context.Context, _ resource.SchemaReques
 				},
 			},
 			"rule": schema.ListNestedAttribute{
-				Optional: true,
+				Optional:    true,
+				Description: "The rules for rule-based load balancing. If omitted, all rules are removed unless `manage_rules` is `false`",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"host": schema.StringAttribute{
@@ -515,6 +567,24 @@ func (r *enhancedLBResource) Schema(ctx context.Context, _ resource.SchemaReques
 				Description: "A list of CIDR block used by the Enhanced LB to access the server",
 			},
 			"monitoring_suite": common.SchemaResourceMonitoringSuite("Enhanced LB"),
+			"manage_servers": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to manage the destination servers with `server`. Set `false` to leave the servers untouched when they are managed by `sakura_enhanced_lb_server`. In that case `server` must not be set",
+			},
+			"manage_rules": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to manage the rules with `rule`. Set `false` to leave the rules untouched when they are managed by `sakura_enhanced_lb_rule`. In that case `rule` must not be set",
+			},
+			"manage_certificates": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to manage the certificates with `certificate`. Set `false` to leave the certificates untouched when they are managed by `sakura_enhanced_lb_certificate`. In that case `certificate` must not be set",
+			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true, Update: true, Delete: true,
 			}),
@@ -527,6 +597,27 @@ func (r *enhancedLBResource) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+func (r *enhancedLBResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config enhancedLBResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ManageServers.IsUnknown() && !config.managesServers() && config.Server != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("server"), "Invalid Attribute Combination",
+			"server cannot be set when manage_servers is false")
+	}
+	if !config.ManageRules.IsUnknown() && !config.managesRules() && config.Rule != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rule"), "Invalid Attribute Combination",
+			"rule cannot be set when manage_rules is false")
+	}
+	if !config.ManageCertificates.IsUnknown() && !config.managesCertificates() && !config.Certificate.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("certificate"), "Invalid Attribute Combination",
+			"certificate cannot be set when manage_certificates is false")
+	}
+}
+
 func (r *enhancedLBResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var plan, state *enhancedLBResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -538,6 +629,25 @@ func (r *enhancedLBResource) ModifyPlan(ctx context.Context, req resource.Modify
 	if plan.Plan.ValueInt64() != state.Plan.ValueInt64() {
 		resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())
 	}
+
+	// certificateのsourceの設定が変更された場合などは既にUnknownになっており、Updateで再取得される
+	planCert, stateCert := plan.certificate(ctx), state.certificate(ctx)
+	if planCert == nil || planCert.Source == nil || stateCert == nil || planCert.SourceVersion.IsUnknown() {
+		return
+	}
+	version, err := readEnhancedLBCertificateSourceVersion(ctx, r.client, planCert.Source)
+	if err != nil {
+		resp.Diagnostics.AddError("ModifyPlan: Source Error", err.Error())
+		return
+	}
+	if version == stateCert.SourceVersion.ValueString() {
+		return
+	}
+
+	// 参照先が更新されている場合は証明書を再設定するため、証明書から算出される値をUnknownにする
+	for _, name := range []string{"source_version", "server_cert", "intermediate_cert", "common_name", "subject_alt_names", "expires_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("certificate").AtName(name), types.StringUnknown())...)
+	}
 }
 
 func (r *enhancedLBResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -550,6 +660,13 @@ func (r *enhancedLBResource) Create(ctx context.Context, req resource.CreateRequ
 	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout20min)
 	defer cancel()
 
+	// 証明書の取得に失敗した場合にEnhanced LBが作成済みのまま残らないよう、作成前に解決しておく
+	certs, err := r.expandCertificates(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Create: Source Error", err.Error())
+		return
+	}
+
 	enhancedLBOp := iaas.NewProxyLBOp(r.client)
 	elb, err := enhancedLBOp.Create(ctx, expandEnhancedLBCreateRequest(&plan))
 	if err != nil {
@@ -557,23 +674,25 @@ func (r *enhancedLBResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	certs := expandEnhancedLBCerts(&plan)
+	var certDiags diag.Diagnostics
 	if certs != nil {
 		_, err := enhancedLBOp.SetCertificates(ctx, elb.ID, &iaas.ProxyLBSetCertificatesRequest{
 			PrimaryCerts:    certs.PrimaryCert,
 			AdditionalCerts: certs.AdditionalCerts,
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to set Certificates to Enhanced LB[%s]: %s", elb.ID, err))
-			return
+			certDiags.AddError("Create: API Error", fmt.Sprintf("failed to set Certificates to Enhanced LB[%s]: %s", elb.ID, err))
 		}
 	}
 
+	// 証明書の設定に失敗してもEnhanced LBは作成済みのため、stateに保存してからエラーを返す
 	if err := plan.updateState(ctx, r.client, elb); err != nil {
+		resp.Diagnostics.Append(certDiags...)
 		resp.Diagnostics.AddError("Create: Terraform Error", "failed to update Enhanced LB state: "+err.Error())
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(certDiags...)
 }
 
 func (r *enhancedLBResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -617,7 +736,15 @@ func (r *enhancedLBResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	elb, err := enhancedLBOp.Update(ctx, common.SakuraCloudID(id), expandEnhancedLBUpdateRequest(&plan, &state))
+	updateReq := expandEnhancedLBUpdateRequest(&plan, &state)
+	// manage_servers/manage_rulesがfalseの場合は他のリソースで管理されているものとして現在の値を維持する
+	if !plan.managesServers() {
+		updateReq.Servers = elb.Servers
+	}
+	if !plan.managesRules() {
+		updateReq.Rules = elb.Rules
+	}
+	elb, err := enhancedLBOp.Update(ctx, common.SakuraCloudID(id), updateReq)
 	if err != nil {
 		resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("failed to update Enhanced LB[%s]: %s", id, err))
 		return
@@ -635,8 +762,13 @@ func (r *enhancedLBResource) Update(ctx context.Context, req resource.UpdateRequ
 		plan.ID = types.StringValue(elb.ID.String())
 	}
 
-	if elb.LetsEncrypt == nil && !plan.Certificate.Equal(state.Certificate) {
-		certs := expandEnhancedLBCerts(&plan)
+	// manage_certificatesがfalseの場合は他のリソースで管理されているものとして証明書を変更しない
+	if elb.LetsEncrypt == nil && plan.managesCertificates() && !plan.Certificate.Equal(state.Certificate) {
+		certs, err := r.expandCertificates(ctx, &plan)
+		if err != nil {
+			resp.Diagnostics.AddError("Update: Source Error", err.Error())
+			return
+		}
 		if certs == nil {
 			if err := enhancedLBOp.DeleteCertificates(ctx, elb.ID); err != nil {
 				resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("failed to delete Certificates of Enhanced LB[%s]: %s", elb.ID, err))
@@ -688,6 +820,123 @@ func (r *enhancedLBResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// updateState はmanage_servers/manage_rules/manage_certificatesがfalseの場合はserver/rule/certificateをstateに反映しない。
+// これらはsakura_enhanced_lb_server/sakura_enhanced_lb_rule/sakura_enhanced_lb_certificateで管理されるため
+func (model *enhancedLBResourceModel) updateState(ctx context.Context, client *common.APIClient, data *iaas.ProxyLB) error {
+	// certificateはデータソースと共通の型で反映した後に、参照先(source)の設定を引き継ぐ
+	cert := model.certificate(ctx)
+	model.Certificate = types.ObjectNull(enhancedLBCertificateModel{}.AttributeTypes())
+	if cert != nil {
+		v, diags := types.ObjectValueFrom(ctx, enhancedLBCertificateModel{}.AttributeTypes(), cert.enhancedLBCertificateModel)
+		if diags.HasError() {
+			return fmt.Errorf("failed to convert certificate: %v", diags)
+		}
+		model.Certificate = v
+	}
+	if err := model.enhancedLBBaseModel.updateState(ctx, client, data); err != nil {
+		return err
+	}
+	if err := model.updateCertificateSourceState(ctx, cert); err != nil {
+		return err
+	}
+
+	// インポート時は未設定となるため、デフォルト値と同じく管理対象とする
+	if model.ManageServers.IsNull() {
+		model.ManageServers = types.BoolValue(true)
+	}
+	if model.ManageRules.IsNull() {
+		model.ManageRules = types.BoolValue(true)
+	}
+	if model.ManageCertificates.IsNull() {
+		model.ManageCertificates = types.BoolValue(true)
+	}
+	if !model.managesServers() {
+		model.Server = nil
+	}
+	if !model.managesRules() {
+		model.Rule = nil
+	}
+	if !model.managesCertificates() {
+		model.Certificate = types.ObjectNull(enhancedLBResourceCertificateModel{}.AttributeTypes())
+	}
+	return nil
+}
+
+// updateCertificateSourceState はデータソースと共通の型で反映されたcertificateに、prevのsourceとsource_versionを引き継ぐ
+func (model *enhancedLBResourceModel) updateCertificateSourceState(ctx context.Context, prev *enhancedLBResourceCertificateModel) error {
+	attrTypes := enhancedLBResourceCertificateModel{}.AttributeTypes()
+	if model.Certificate.IsNull() {
+		model.Certificate = types.ObjectNull(attrTypes)
+		return nil
+	}
+
+	cert := enhancedLBResourceCertificateModel{SourceVersion: types.StringNull()}
+	if diags := model.Certificate.As(ctx, &cert.enhancedLBCertificateModel, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return fmt.Errorf("failed to convert certificate: %v", diags)
+	}
+	if prev != nil && prev.Source != nil {
+		cert.Source = prev.Source
+		cert.SourceVersion = prev.SourceVersion
+	}
+
+	v, diags := types.ObjectValueFrom(ctx, attrTypes, cert)
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert certificate: %v", diags)
+	}
+	model.Certificate = v
+	return nil
+}
+
+// certificate はcertificateの値を返す。未設定(null/unknown)の場合や変換できない場合はnilを返す
+func (model *enhancedLBResourceModel) certificate(ctx context.Context) *enhancedLBResourceCertificateModel {
+	if !utils.IsKnown(model.Certificate) {
+		return nil
+	}
+	var cert enhancedLBResourceCertificateModel
+	if diags := model.Certificate.As(ctx, &cert, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return nil
+	}
+	return &cert
+}
+
+// expandCertificates はcertificateの証明書を返す。未設定の場合はnilを返す。
+// sourceが指定されている場合はプライマリ証明書を参照先から取得し、参照先のバージョンをsource_versionに設定する
+func (r *enhancedLBResource) expandCertificates(ctx context.Context, model *enhancedLBResourceModel) (*iaas.ProxyLBCertificates, error) {
+	cert := model.certificate(ctx)
+	if cert == nil {
+		return nil, nil
+	}
+
+	certs := expandEnhancedLBCerts(cert)
+	if cert.Source != nil {
+		primaryCert, version, err := expandEnhancedLBCertificateSource(ctx, r.client, cert.Source)
+		if err != nil {
+			return nil, err
+		}
+		certs.PrimaryCert = primaryCert
+		cert.SourceVersion = version
+
+		v, diags := types.ObjectValueFrom(ctx, cert.AttributeTypes(), cert)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to convert certificate: %v", diags)
+		}
+		model.Certificate = v
+	}
+	return certs, nil
+}
+
+func (model *enhancedLBResourceModel) managesServers() bool {
+	return model.ManageServers.IsNull() || model.ManageServers.ValueBool()
+}
+
+func (model *enhancedLBResourceModel) managesRules() bool {
+	return model.ManageRules.IsNull() || model.ManageRules.ValueBool()
+}
+
+func (model *enhancedLBResourceModel) managesCertificates() bool {
+	return model.ManageCertificates.IsNull() || model.ManageCertificates.ValueBool()
+}
+
 func getELB(ctx context.Context, client *common.APIClient, id string, state *tfsdk.State, diags *diag.Diagnostics) *iaas.ProxyLB {
 	enhancedLBOp := iaas.NewProxyLBOp(client)
 	elb, err := enhancedLBOp.Read(ctx, common.SakuraCloudID(id))
@@ -140,6 +140,10 @@ func (r *enhancedLBACMEResource) Schema(ctx context.Context, _ resource.SchemaRe
 						Computed:    true,
 						Description: "The subject alternative names of the certificate",
 					},
+					"expires_at": schema.StringAttribute{
+						Computed:    true,
+						Description: "The expiration date of the certificate in RFC3339 format",
+					},
 					"additional_certificate": schema.ListNestedAttribute{
 						Computed:    true,
 						Description: "The additional certificates",
@@ -158,6 +162,10 @@ func (r *enhancedLBACMEResource) Schema(ctx context.Context, _ resource.SchemaRe
 									Sensitive:   true,
 									Description: "The private key for a server",
 								},
+								"expires_at": schema.StringAttribute{
+									Computed:    true,
+									Description: "The expiration date of the certificate in RFC3339 format",
+								},
 							},
 						},
 					},
@@ -167,7 +175,9 @@ func (r *enhancedLBACMEResource) Schema(ctx context.Context, _ resource.SchemaRe
 				Create: true, Delete: true,
 			}),
 		},
-		MarkdownDescription: "Manages an Enhanced Load Balancer's ACME",
+		MarkdownDescription: "Manages an Enhanced Load Balancer's ACME\n\n" +
+			"~> **Note:** The certificate is issued by the Let's Encrypt integration of the Enhanced LB, which supports only HTTP-01 challenges. " +
+			"To use a certificate issued with DNS-01 challenges, store it in Secret Manager or Object Storage and set it with `source` of `sakura_enhanced_lb_certificate` instead.",
 	}
 }
 
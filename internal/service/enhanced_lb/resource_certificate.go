@@ -0,0 +1,610 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package enhanced_lb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/minio/minio-go/v7"
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	sm "github.com/sacloud/secretmanager-api-go"
+	v1 "github.com/sacloud/secretmanager-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/service/object_storage"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type enhancedLBCertificateResource struct {
+	client *common.APIClient
+}
+
+var (
+	_ resource.Resource               = &enhancedLBCertificateResource{}
+	_ resource.ResourceWithConfigure  = &enhancedLBCertificateResource{}
+	_ resource.ResourceWithModifyPlan = &enhancedLBCertificateResource{}
+)
+
+func NewEnhancedLBCertificateResource() resource.Resource {
+	return &enhancedLBCertificateResource{}
+}
+
+func (r *enhancedLBCertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enhanced_lb_certificate"
+}
+
+func (r *enhancedLBCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient
+}
+
+type enhancedLBCertificateResourceModel struct {
+	enhancedLBCertificateModel
+	ID            types.String                      `tfsdk:"id"`
+	EnhancedLBID  types.String                      `tfsdk:"enhanced_lb_id"`
+	Source        *enhancedLBCertificateSourceModel `tfsdk:"source"`
+	SourceVersion types.String                      `tfsdk:"source_version"`
+	Timeouts      timeouts.Value                    `tfsdk:"timeouts"`
+}
+
+type enhancedLBCertificateSourceModel struct {
+	SecretManager *enhancedLBCertificateSecretManagerModel `tfsdk:"secret_manager"`
+	ObjectStorage *enhancedLBCertificateObjectStorageModel `tfsdk:"object_storage"`
+}
+
+func (m enhancedLBCertificateSourceModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"secret_manager": types.ObjectType{AttrTypes: enhancedLBCertificateSecretManagerModel{}.AttributeTypes()},
+		"object_storage": types.ObjectType{AttrTypes: enhancedLBCertificateObjectStorageModel{}.AttributeTypes()},
+	}
+}
+
+type enhancedLBCertificateSecretManagerModel struct {
+	VaultID types.String `tfsdk:"vault_id"`
+	Name    types.String `tfsdk:"name"`
+	Version types.Int64  `tfsdk:"version"`
+}
+
+func (m enhancedLBCertificateSecretManagerModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"vault_id": types.StringType,
+		"name":     types.StringType,
+		"version":  types.Int64Type,
+	}
+}
+
+type enhancedLBCertificateObjectStorageModel struct {
+	Endpoint  types.String `tfsdk:"endpoint"`
+	Region    types.String `tfsdk:"region"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+	Bucket    types.String `tfsdk:"bucket"`
+	Key       types.String `tfsdk:"key"`
+}
+
+func (m enhancedLBCertificateObjectStorageModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"endpoint":   types.StringType,
+		"region":     types.StringType,
+		"access_key": types.StringType,
+		"secret_key": types.StringType,
+		"bucket":     types.StringType,
+		"key":        types.StringType,
+	}
+}
+
+func (r *enhancedLBCertificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("Enhanced LB Certificate"),
+			"enhanced_lb_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the Enhanced LB that the certificates are set to",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_cert": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The certificate for a server. Either this or `source` must be specified",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source")),
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key")),
+				},
+			},
+			"intermediate_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "The intermediate certificate for a server",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("source")),
+				},
+			},
+			"private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The private key for a server",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("source")),
+				},
+			},
+			"source": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The external source of the primary certificate. The source must contain a PEM bundle of the server certificate, the intermediate certificates and the unencrypted private key. " +
+					"The first certificate in the bundle is used as the server certificate. " +
+					"When the source is updated, the change is detected on plan and the certificate is set again",
+				Attributes: schemaEnhancedLBCertificateSourceAttributes(),
+			},
+			"source_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "The version of `source` that the certificate was loaded from. The version of the secret for Secret Manager, or the ETag of the object for Object Storage",
+			},
+			"common_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The common name of the certificate",
+			},
+			"subject_alt_names": schema.StringAttribute{
+				Computed:    true,
+				Description: "The subject alternative names of the certificate",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiration date of the certificate in RFC3339 format",
+			},
+			"additional_certificate": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The additional certificates",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_cert": schema.StringAttribute{
+							Required:    true,
+							Description: "The certificate for a server",
+						},
+						"intermediate_cert": schema.StringAttribute{
+							Optional:    true,
+							Description: "The intermediate certificate for a server",
+						},
+						"private_key": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "The private key for a server",
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The expiration date of the certificate in RFC3339 format",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+		MarkdownDescription: "Manages the certificates of an Enhanced Load Balancer.\n\n" +
+			"The primary certificate can be loaded from Secret Manager or Object Storage with `source`. This is useful for certificates issued outside of the Enhanced LB (e.g. by ACME DNS-01 challenges).\n\n" +
+			"~> **Note:** The API doesn't return private keys, so this resource manages the whole set of certificates (primary and additional) of the Enhanced LB. " +
+			"Use only one `sakura_enhanced_lb_certificate` per Enhanced LB, set `manage_certificates = false` in `sakura_enhanced_lb` and don't use `sakura_enhanced_lb_acme` for the same Enhanced LB.",
+	}
+}
+
+// schemaEnhancedLBCertificateSourceAttributes は証明書の参照先(sourceの属性)のスキーマを返す
+func schemaEnhancedLBCertificateSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"secret_manager": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "The secret of Secret Manager that stores the PEM bundle",
+			Validators: []validator.Object{
+				objectvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("object_storage")),
+			},
+			Attributes: map[string]schema.Attribute{
+				"vault_id": schema.StringAttribute{
+					Required:    true,
+					Description: "The ID of the Secret Manager vault",
+					Validators: []validator.String{
+						sacloudvalidator.SakuraIDValidator(),
+					},
+				},
+				"name": schema.StringAttribute{
+					Required:    true,
+					Description: "The name of the secret",
+				},
+				"version": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The version of the secret. If omitted, the latest version is used and the certificate follows new versions of the secret",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+			},
+		},
+		"object_storage": schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: "The object of Object Storage that stores the PEM bundle",
+			Attributes: map[string]schema.Attribute{
+				"endpoint": schema.StringAttribute{
+					Optional:    true,
+					Description: "The endpoint of Object Storage. If omitted, `object_storage.endpoint` in the provider configuration is used",
+				},
+				"region": schema.StringAttribute{
+					Optional:    true,
+					Description: "The region of Object Storage. If omitted, `object_storage.region` in the provider configuration is used",
+				},
+				"access_key": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The access key for Object Storage. If omitted, `object_storage.access_key` in the provider configuration is used",
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_key")),
+					},
+				},
+				"secret_key": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The secret key for Object Storage. If omitted, `object_storage.secret_key` in the provider configuration is used",
+					Validators: []validator.String{
+						stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("access_key")),
+					},
+				},
+				"bucket": schema.StringAttribute{
+					Required:    true,
+					Description: "The bucket of the object",
+				},
+				"key": schema.StringAttribute{
+					Required:    true,
+					Description: "The key of the object",
+				},
+			},
+		},
+	}
+}
+
+func (r *enhancedLBCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan enhancedLBCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := plan.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("Enhanced LB[%s] is not found", elbID))
+		}
+		return
+	}
+	if elb.LetsEncrypt != nil && elb.LetsEncrypt.Enabled {
+		resp.Diagnostics.AddError("Create: Conflict Error", fmt.Sprintf("certificates of Enhanced LB[%s] are managed by ACME(Let's Encrypt)", elbID))
+		return
+	}
+
+	primaryCert, version, err := r.expandPrimaryCert(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Create: Source Error", err.Error())
+		return
+	}
+	certs, err := r.setCertificates(ctx, elb.ID, primaryCert, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Create: API Error", err.Error())
+		return
+	}
+
+	plan.SourceVersion = version
+
+	plan.updateState(elb.ID.String(), certs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *enhancedLBCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state enhancedLBCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elb := getELB(ctx, r.client, state.EnhancedLBID.ValueString(), &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	certs, err := iaas.NewProxyLBOp(r.client).GetCertificates(ctx, elb.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read certificates of Enhanced LB[%s]: %s", elb.ID, err))
+		return
+	}
+	if certs == nil || certs.PrimaryCert == nil || certs.PrimaryCert.ServerCertificate == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.updateState(elb.ID.String(), certs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *enhancedLBCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan enhancedLBCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := plan.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	primaryCert, version, err := r.expandPrimaryCert(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Update: Source Error", err.Error())
+		return
+	}
+	certs, err := r.setCertificates(ctx, elb.ID, primaryCert, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Update: API Error", err.Error())
+		return
+	}
+
+	plan.SourceVersion = version
+
+	plan.updateState(elb.ID.String(), certs)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *enhancedLBCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state enhancedLBCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := state.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	if err := iaas.NewProxyLBOp(r.client).DeleteCertificates(ctx, elb.ID); err != nil {
+		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to delete certificates of Enhanced LB[%s]: %s", elb.ID, err))
+		return
+	}
+}
+
+func (r *enhancedLBCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan, state *enhancedLBCertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if state == nil || plan == nil || plan.Source == nil {
+		return
+	}
+	// sourceの設定が変更された場合などは既にUnknownになっており、Updateで再取得される
+	if plan.SourceVersion.IsUnknown() {
+		return
+	}
+
+	version, err := readEnhancedLBCertificateSourceVersion(ctx, r.client, plan.Source)
+	if err != nil {
+		resp.Diagnostics.AddError("ModifyPlan: Source Error", err.Error())
+		return
+	}
+	if version == state.SourceVersion.ValueString() {
+		return
+	}
+
+	// 参照先が更新されている場合は証明書を再設定するため、証明書から算出される値をUnknownにする
+	for _, name := range []string{"source_version", "server_cert", "common_name", "subject_alt_names", "expires_at"} {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+}
+
+// expandPrimaryCert はプライマリ証明書を返す。sourceが指定されている場合は参照先から取得し、参照先のバージョンも返す
+func (r *enhancedLBCertificateResource) expandPrimaryCert(ctx context.Context, model *enhancedLBCertificateResourceModel) (*iaas.ProxyLBPrimaryCert, types.String, error) {
+	if model.Source == nil {
+		return &iaas.ProxyLBPrimaryCert{
+			ServerCertificate:       model.ServerCert.ValueString(),
+			IntermediateCertificate: model.IntermediateCert.ValueString(),
+			PrivateKey:              model.PrivateKey.ValueString(),
+		}, types.StringNull(), nil
+	}
+
+	return expandEnhancedLBCertificateSource(ctx, r.client, model.Source)
+}
+
+// expandEnhancedLBCertificateSource はsourceの参照先からプライマリ証明書と参照先のバージョンを取得する
+func expandEnhancedLBCertificateSource(ctx context.Context, client *common.APIClient, source *enhancedLBCertificateSourceModel) (*iaas.ProxyLBPrimaryCert, types.String, error) {
+	bundle, version, err := readEnhancedLBCertificateSource(ctx, client, source)
+	if err != nil {
+		return nil, types.StringNull(), err
+	}
+	cert, err := expandEnhancedLBCertificateBundle(bundle)
+	if err != nil {
+		return nil, types.StringNull(), fmt.Errorf("failed to parse certificate bundle from %s: %s", source.description(), err)
+	}
+	return cert, types.StringValue(version), nil
+}
+
+// readEnhancedLBCertificateSource はsourceからPEMバンドルとそのバージョンを取得する
+func readEnhancedLBCertificateSource(ctx context.Context, client *common.APIClient, source *enhancedLBCertificateSourceModel) (string, string, error) {
+	switch {
+	case source.SecretManager != nil:
+		s := source.SecretManager
+		unveilReq := v1.Unveil{Name: s.Name.ValueString()}
+		if !s.Version.IsNull() {
+			unveilReq.Version = v1.NewOptNilInt(int(s.Version.ValueInt64()))
+		}
+		unveil, err := sm.NewSecretOp(client.SecretManagerClient, s.VaultID.ValueString()).Unveil(ctx, unveilReq)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to unveil %s: %s", source.description(), err)
+		}
+		version := ""
+		if unveil.Version.IsSet() {
+			version = strconv.Itoa(unveil.Version.Value)
+		}
+		return unveil.Value, version, nil
+	case source.ObjectStorage != nil:
+		o := source.ObjectStorage
+		client, err := object_storage.NewMinIOClientWithDefaults(&client.ObjectStorageConfig, o.Endpoint, o.Region, o.AccessKey, o.SecretKey)
+		if err != nil {
+			return "", "", err
+		}
+		obj, err := client.GetObject(ctx, o.Bucket.ValueString(), o.Key.ValueString(), minio.GetObjectOptions{})
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get %s: %s", source.description(), err)
+		}
+		defer func() { _ = obj.Close() }()
+		info, err := obj.Stat()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get information of %s: %s", source.description(), err)
+		}
+		content, err := io.ReadAll(obj)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to read %s: %s", source.description(), err)
+		}
+		return string(content), info.ETag, nil
+	default:
+		return "", "", errors.New("either secret_manager or object_storage must be specified in source")
+	}
+}
+
+// readEnhancedLBCertificateSourceVersion はsourceのバージョンのみを取得する。Object Storageの場合はオブジェクトの内容を取得せずにETagを参照する
+func readEnhancedLBCertificateSourceVersion(ctx context.Context, client *common.APIClient, source *enhancedLBCertificateSourceModel) (string, error) {
+	if o := source.ObjectStorage; o != nil {
+		client, err := object_storage.NewMinIOClientWithDefaults(&client.ObjectStorageConfig, o.Endpoint, o.Region, o.AccessKey, o.SecretKey)
+		if err != nil {
+			return "", err
+		}
+		info, err := client.StatObject(ctx, o.Bucket.ValueString(), o.Key.ValueString(), minio.StatObjectOptions{})
+		if err != nil {
+			return "", fmt.Errorf("failed to get information of %s: %s", source.description(), err)
+		}
+		return info.ETag, nil
+	}
+
+	_, version, err := readEnhancedLBCertificateSource(ctx, client, source)
+	return version, err
+}
+
+func (source *enhancedLBCertificateSourceModel) description() string {
+	if s := source.SecretManager; s != nil {
+		return fmt.Sprintf("Secret Manager secret[%s/%s]", s.VaultID.ValueString(), s.Name.ValueString())
+	}
+	if o := source.ObjectStorage; o != nil {
+		return fmt.Sprintf("Object Storage object[%s/%s]", o.Bucket.ValueString(), o.Key.ValueString())
+	}
+	return "certificate source"
+}
+
+func (r *enhancedLBCertificateResource) setCertificates(ctx context.Context, id iaastypes.ID, primaryCert *iaas.ProxyLBPrimaryCert, model *enhancedLBCertificateResourceModel) (*iaas.ProxyLBCertificates, error) {
+	req := &iaas.ProxyLBSetCertificatesRequest{
+		PrimaryCerts: primaryCert,
+	}
+	for _, c := range model.AdditionalCertificate {
+		req.AdditionalCerts = append(req.AdditionalCerts, &iaas.ProxyLBAdditionalCert{
+			ServerCertificate:       c.ServerCert.ValueString(),
+			IntermediateCertificate: c.IntermediateCert.ValueString(),
+			PrivateKey:              c.PrivateKey.ValueString(),
+		})
+	}
+
+	certs, err := iaas.NewProxyLBOp(r.client).SetCertificates(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set certificates to Enhanced LB[%s]: %s", id, err)
+	}
+	return certs, nil
+}
+
+// updateState はAPIから取得した証明書をstateに反映する。
+// 秘密鍵はAPIレスポンスに含まれないため既存の値を維持し、証明書は前後の空白の違いのみであれば既存の値を維持する
+func (model *enhancedLBCertificateResourceModel) updateState(elbID string, certs *iaas.ProxyLBCertificates) {
+	model.ID = types.StringValue(elbID)
+	model.EnhancedLBID = types.StringValue(elbID)
+	model.CommonName = types.StringValue("")
+	model.SubjectAltNames = types.StringValue("")
+	model.ExpiresAt = types.StringNull()
+
+	if pc := certs.PrimaryCert; pc != nil {
+		model.ServerCert = keepEnhancedLBCertificateValue(model.ServerCert, pc.ServerCertificate)
+		// sourceから設定した場合、中間証明書と秘密鍵はstateに保持しない
+		if model.Source == nil {
+			model.IntermediateCert = keepEnhancedLBCertificateValue(model.IntermediateCert, pc.IntermediateCertificate)
+		}
+		model.CommonName = types.StringValue(pc.CertificateCommonName)
+		model.SubjectAltNames = types.StringValue(pc.CertificateAltNames)
+		model.ExpiresAt = flattenEnhancedLBCertificateEndDate(pc.CertificateEndDate)
+	}
+
+	var additionalCerts []enhancedLBAdditionalCertificateModel
+	for _, ac := range certs.AdditionalCerts {
+		c := enhancedLBAdditionalCertificateModel{
+			ServerCert:       types.StringValue(ac.ServerCertificate),
+			IntermediateCert: types.StringNull(),
+			PrivateKey:       types.StringValue(""),
+			ExpiresAt:        flattenEnhancedLBCertificateEndDate(ac.CertificateEndDate),
+		}
+		for _, prev := range model.AdditionalCertificate {
+			if strings.TrimSpace(prev.ServerCert.ValueString()) == strings.TrimSpace(ac.ServerCertificate) {
+				c.ServerCert = prev.ServerCert
+				c.IntermediateCert = keepEnhancedLBCertificateValue(prev.IntermediateCert, ac.IntermediateCertificate)
+				c.PrivateKey = prev.PrivateKey
+				break
+			}
+		}
+		if c.IntermediateCert.IsNull() && ac.IntermediateCertificate != "" {
+			c.IntermediateCert = types.StringValue(ac.IntermediateCertificate)
+		}
+		additionalCerts = append(additionalCerts, c)
+	}
+	model.AdditionalCertificate = additionalCerts
+}
+
+func keepEnhancedLBCertificateValue(prev types.String, current string) types.String {
+	if strings.TrimSpace(prev.ValueString()) == strings.TrimSpace(current) {
+		if prev.IsNull() {
+			return types.StringNull()
+		}
+		return prev
+	}
+	return types.StringValue(current)
+}
@@ -0,0 +1,142 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package enhanced_lb_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraEnhancedLBCertificate_basic(t *testing.T) {
+	rand := test.RandomName()
+	resourceName := "sakura_enhanced_lb_certificate.foobar"
+
+	var elb iaas.ProxyLB
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraEnhancedLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBCertificate_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraEnhancedLBExists("sakura_enhanced_lb.foobar", &elb),
+					resource.TestCheckResourceAttrPair(resourceName, "enhanced_lb_id", "sakura_enhanced_lb.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "common_name", "example.sakura.ne.jp"),
+					resource.TestCheckResourceAttr(resourceName, "additional_certificate.#", "0"),
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBCertificate_update, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "common_name", "example.sakura.ne.jp"),
+					resource.TestCheckResourceAttr(resourceName, "additional_certificate.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSakuraEnhancedLBCertificate_secretManager(t *testing.T) {
+	rand := test.RandomName()
+	resourceName := "sakura_enhanced_lb_certificate.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraEnhancedLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBCertificate_secretManager, rand, "rsa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "common_name", "example.sakura.ne.jp"),
+					resource.TestCheckResourceAttr(resourceName, "source_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+					resource.TestCheckNoResourceAttr(resourceName, "private_key"),
+				),
+			},
+			{
+				// シークレットの更新は次のplanで検出されるため、このステップでは証明書は更新されない
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBCertificate_secretManager, rand, "ecdsa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "source_version", "1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBCertificate_secretManager, rand, "ecdsa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "source_version", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_at"),
+				),
+			},
+		},
+	})
+}
+
+var testAccSakuraEnhancedLBCertificate_base = `
+resource "sakura_enhanced_lb" "foobar" {
+  name                = "{{ .arg0 }}"
+  manage_certificates = false
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "https"
+    port       = 443
+  }]
+}
+`
+
+var testAccSakuraEnhancedLBCertificate_basic = testAccSakuraEnhancedLBCertificate_base + `
+resource "sakura_enhanced_lb_certificate" "foobar" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  server_cert    = file("testdata/rsa.crt")
+  private_key    = file("testdata/rsa.key")
+}
+`
+
+var testAccSakuraEnhancedLBCertificate_update = testAccSakuraEnhancedLBCertificate_base + `
+resource "sakura_enhanced_lb_certificate" "foobar" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  server_cert    = file("testdata/rsa.crt")
+  private_key    = file("testdata/rsa.key")
+
+  additional_certificate = [{
+    server_cert = file("testdata/ecdsa.crt")
+    private_key = file("testdata/ecdsa.key")
+  }]
+}
+`
+
+var testAccSakuraEnhancedLBCertificate_secretManager = testAccSakuraEnhancedLBCertificate_base + `
+resource "sakura_kms" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_secret_manager" "foobar" {
+  name       = "{{ .arg0 }}"
+  kms_key_id = sakura_kms.foobar.id
+}
+
+resource "sakura_secret_manager_secret" "foobar" {
+  name     = "{{ .arg0 }}"
+  vault_id = sakura_secret_manager.foobar.id
+  value    = join("", [file("testdata/{{ .arg1 }}.crt"), file("testdata/{{ .arg1 }}.key")])
+}
+
+resource "sakura_enhanced_lb_certificate" "foobar" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  source = {
+    secret_manager = {
+      vault_id = sakura_secret_manager_secret.foobar.vault_id
+      name     = sakura_secret_manager_secret.foobar.name
+    }
+  }
+}
+`
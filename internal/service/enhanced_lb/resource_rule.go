@@ -0,0 +1,338 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package enhanced_lb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type enhancedLBRuleResource struct {
+	client *common.APIClient
+}
+
+var (
+	_ resource.Resource              = &enhancedLBRuleResource{}
+	_ resource.ResourceWithConfigure = &enhancedLBRuleResource{}
+)
+
+func NewEnhancedLBRuleResource() resource.Resource {
+	return &enhancedLBRuleResource{}
+}
+
+func (r *enhancedLBRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enhanced_lb_rule"
+}
+
+func (r *enhancedLBRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient
+}
+
+type enhancedLBRuleResourceModel struct {
+	enhancedLBRuleModel
+	ID           types.String   `tfsdk:"id"`
+	EnhancedLBID types.String   `tfsdk:"enhanced_lb_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *enhancedLBRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	conditionString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: description,
+			Default:     stringdefault.StaticString(""),
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+	conditionBool := func(description string) schema.BoolAttribute {
+		return schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: description,
+			Default:     booldefault.StaticBool(false),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("Enhanced LB Rule"),
+			"enhanced_lb_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the Enhanced LB that the rule is added to",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host":                             conditionString("The value of HTTP host header that is used as condition of rule-based balancing"),
+			"path":                             conditionString("The request path that is used as condition of rule-based balancing"),
+			"source_ips":                       conditionString("IP address or CIDR block to which the rule will be applied. Multiple values can be specified by separating them with a space or comma"),
+			"request_header_name":              conditionString("The header name that the client will send when making a request"),
+			"request_header_value":             conditionString("The condition for the value of the request header specified by the request header name"),
+			"request_header_value_ignore_case": conditionBool("Boolean value representing whether the request header value ignores case"),
+			"request_header_value_not_match":   conditionBool("Boolean value representing whether to apply the rules when the request header value conditions are met or when the conditions do not match"),
+			"group": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The name of load balancing group. When enhanced LB received request which matched to the conditions, enhanced LB forwards the request to servers that having same group name. %s", desc.Length(1, 10)),
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 10),
+				},
+			},
+			"action": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The type of action to be performed when requests matches the rule. This must be one of [%s]", iaastypes.ProxyLBRuleActionStrings()),
+				Default:     stringdefault.StaticString(iaastypes.ProxyLBRuleActions.Forward.String()),
+				Validators: []validator.String{
+					stringvalidator.OneOf(iaastypes.ProxyLBRuleActionStrings()...),
+				},
+			},
+			"redirect_location": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The URL to redirect to when the request matches the rule. see https://manual.sakura.ad.jp/cloud/appliance/enhanced-lb/#enhanced-lb-rule for details",
+			},
+			"redirect_status_code": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("HTTP status code for redirects sent when requests matches the rule. This must be one of [%s]", iaastypes.ProxyLBRedirectStatusCodeStrings()),
+				Validators: []validator.String{
+					stringvalidator.OneOf(iaastypes.ProxyLBRedirectStatusCodeStrings()...),
+				},
+			},
+			"fixed_status_code": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("HTTP status code for fixed response sent when requests matches the rule. This must be one of [%s]", iaastypes.ProxyLBFixedStatusCodeStrings()),
+				Validators: []validator.String{
+					stringvalidator.OneOf(iaastypes.ProxyLBFixedStatusCodeStrings()...),
+				},
+			},
+			"fixed_content_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("Content-Type header value for fixed response sent when requests matches the rule. This must be one of [%s]", iaastypes.ProxyLBFixedContentTypeStrings()),
+				Validators: []validator.String{
+					stringvalidator.OneOf(iaastypes.ProxyLBFixedContentTypeStrings()...),
+				},
+			},
+			"fixed_message_body": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Content body for fixed response sent when requests matches the rule",
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+		MarkdownDescription: "Manages a rule of an Enhanced Load Balancer. A rule is identified by its conditions (`host`, `path`, `source_ips` and `request_header_*`). Rules not managed by this resource are left untouched.\n\nA new rule is appended to the end of the rules and an updated rule keeps its position. Since Enhanced LB evaluates rules in order, use `depends_on` to create rules in the order they should be evaluated.\n\n~> **Note:** Set `manage_rules = false` in `sakura_enhanced_lb` for the same Enhanced LB, otherwise both resources will overwrite each other.",
+	}
+}
+
+func (r *enhancedLBRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan enhancedLBRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := plan.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("Enhanced LB[%s] is not found", elbID))
+		}
+		return
+	}
+
+	rule := expandEnhancedLBRule(&plan.enhancedLBRuleModel)
+	if findEnhancedLBRule(elb.Rules, rule) >= 0 {
+		resp.Diagnostics.AddError("Create: Conflict Error", fmt.Sprintf("a rule with the same conditions already exists in Enhanced LB[%s]", elbID))
+		return
+	}
+
+	settings := expandEnhancedLBUpdateSettingsRequest(elb)
+	settings.Rules = append(settings.Rules, rule)
+	elb, err := iaas.NewProxyLBOp(r.client).UpdateSettings(ctx, elb.ID, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to add rule to Enhanced LB[%s]: %s", elbID, err))
+		return
+	}
+
+	if !plan.updateState(elb, rule) {
+		resp.Diagnostics.AddError("Create: Terraform Error", fmt.Sprintf("rule is not found in Enhanced LB[%s] after adding", elbID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *enhancedLBRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state enhancedLBRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elb := getELB(ctx, r.client, state.EnhancedLBID.ValueString(), &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	rule := expandEnhancedLBRule(&state.enhancedLBRuleModel)
+	if !state.updateState(elb, rule) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *enhancedLBRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan enhancedLBRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := plan.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	rule := expandEnhancedLBRule(&plan.enhancedLBRuleModel)
+	settings := expandEnhancedLBUpdateSettingsRequest(elb)
+	idx := findEnhancedLBRule(settings.Rules, rule)
+	if idx < 0 {
+		resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("rule is not found in Enhanced LB[%s]", elbID))
+		return
+	}
+	settings.Rules[idx] = rule
+
+	elb, err := iaas.NewProxyLBOp(r.client).UpdateSettings(ctx, elb.ID, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("failed to update rule of Enhanced LB[%s]: %s", elbID, err))
+		return
+	}
+
+	if !plan.updateState(elb, rule) {
+		resp.Diagnostics.AddError("Update: Terraform Error", fmt.Sprintf("rule is not found in Enhanced LB[%s] after updating", elbID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *enhancedLBRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state enhancedLBRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := state.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	rule := expandEnhancedLBRule(&state.enhancedLBRuleModel)
+
+	settings := expandEnhancedLBUpdateSettingsRequest(elb)
+	idx := findEnhancedLBRule(settings.Rules, rule)
+	if idx < 0 {
+		return
+	}
+	settings.Rules = slices.Delete(settings.Rules, idx, idx+1)
+
+	if _, err := iaas.NewProxyLBOp(r.client).UpdateSettings(ctx, elb.ID, settings); err != nil {
+		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to remove rule from Enhanced LB[%s]: %s", elbID, err))
+		return
+	}
+}
+
+// updateState はEnhanced LBから条件が一致するルールを探してstateに反映する。見つからない場合はfalseを返す
+func (model *enhancedLBRuleResourceModel) updateState(elb *iaas.ProxyLB, rule *iaas.ProxyLBRule) bool {
+	idx := findEnhancedLBRule(elb.Rules, rule)
+	if idx < 0 {
+		return false
+	}
+
+	model.enhancedLBRuleModel = flattenEnhancedLBRule(elb.Rules[idx])
+	model.ID = types.StringValue(elb.ID.String() + "/" + enhancedLBRuleHash(rule))
+	model.EnhancedLBID = types.StringValue(elb.ID.String())
+	return true
+}
+
+func findEnhancedLBRule(rules []*iaas.ProxyLBRule, rule *iaas.ProxyLBRule) int {
+	return slices.IndexFunc(rules, func(r *iaas.ProxyLBRule) bool {
+		return r != nil && enhancedLBRuleConditionEqual(r, rule)
+	})
+}
+
+func enhancedLBRuleConditionEqual(x, y *iaas.ProxyLBRule) bool {
+	return x.Host == y.Host &&
+		x.Path == y.Path &&
+		x.SourceIPs == y.SourceIPs &&
+		x.RequestHeaderName == y.RequestHeaderName &&
+		x.RequestHeaderValue == y.RequestHeaderValue &&
+		x.RequestHeaderValueIgnoreCase == y.RequestHeaderValueIgnoreCase &&
+		x.RequestHeaderValueNotMatch == y.RequestHeaderValueNotMatch
+}
+
+func enhancedLBRuleHash(rule *iaas.ProxyLBRule) string {
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s\x00%s\x00%s\x00%s\x00%s\x00%t\x00%t",
+		rule.Host, rule.Path, rule.SourceIPs, rule.RequestHeaderName, rule.RequestHeaderValue,
+		rule.RequestHeaderValueIgnoreCase, rule.RequestHeaderValueNotMatch))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,160 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package enhanced_lb_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraEnhancedLBRule_basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envEnhancedLBRealServerIP0)
+
+	rand := test.RandomName()
+	ip := os.Getenv(envEnhancedLBRealServerIP0)
+
+	var elb iaas.ProxyLB
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraEnhancedLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBRule_basic, rand, ip),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraEnhancedLBExists("sakura_enhanced_lb.foobar", &elb),
+					testCheckSakuraEnhancedLBRuleHosts(&elb, "www.usacloud.jp", "api.usacloud.jp", "usacloud.jp"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.www", "group", "group1"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.www", "action", "forward"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.api", "path", "/api"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.api", "action", "fixed"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.api", "fixed_status_code", "200"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.api", "fixed_content_type", "text/plain"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.api", "fixed_message_body", "ok"),
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBRule_update, rand, ip),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraEnhancedLBExists("sakura_enhanced_lb.foobar", &elb),
+					// 更新したルールは元の位置のまま
+					testCheckSakuraEnhancedLBRuleHosts(&elb, "www.usacloud.jp", "api.usacloud.jp", "usacloud.jp"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.api", "action", "redirect"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.api", "redirect_location", "https://redirect.usacloud.jp"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_rule.api", "redirect_status_code", "301"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSakuraEnhancedLBRuleHosts(elb *iaas.ProxyLB, hosts ...string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var actual []string
+		for _, r := range elb.Rules {
+			actual = append(actual, r.Host)
+		}
+		if fmt.Sprint(actual) != fmt.Sprint(hosts) {
+			return fmt.Errorf("unexpected rules in Enhanced LB[%s]: expected: %v, actual: %v", elb.ID, hosts, actual)
+		}
+		return nil
+	}
+}
+
+var testAccSakuraEnhancedLBRule_basic = `
+resource "sakura_enhanced_lb" "foobar" {
+  name         = "{{ .arg0 }}"
+  manage_rules = false
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "http"
+    port       = 80
+  }]
+  server = [{
+    ip_address = "{{ .arg1 }}"
+    port       = 80
+    group      = "group1"
+  }]
+}
+
+resource "sakura_enhanced_lb_rule" "www" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  host           = "www.usacloud.jp"
+  group          = "group1"
+}
+
+resource "sakura_enhanced_lb_rule" "api" {
+  enhanced_lb_id     = sakura_enhanced_lb.foobar.id
+  host               = "api.usacloud.jp"
+  path               = "/api"
+  action             = "fixed"
+  fixed_status_code  = "200"
+  fixed_content_type = "text/plain"
+  fixed_message_body = "ok"
+
+  depends_on = [sakura_enhanced_lb_rule.www]
+}
+
+resource "sakura_enhanced_lb_rule" "catch_all" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  host           = "usacloud.jp"
+  group          = "group1"
+
+  depends_on = [sakura_enhanced_lb_rule.api]
+}
+`
+
+var testAccSakuraEnhancedLBRule_update = `
+resource "sakura_enhanced_lb" "foobar" {
+  name         = "{{ .arg0 }}"
+  manage_rules = false
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "http"
+    port       = 80
+  }]
+  server = [{
+    ip_address = "{{ .arg1 }}"
+    port       = 80
+    group      = "group1"
+  }]
+}
+
+resource "sakura_enhanced_lb_rule" "www" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  host           = "www.usacloud.jp"
+  group          = "group1"
+}
+
+resource "sakura_enhanced_lb_rule" "api" {
+  enhanced_lb_id       = sakura_enhanced_lb.foobar.id
+  host                 = "api.usacloud.jp"
+  path                 = "/api"
+  action               = "redirect"
+  redirect_location    = "https://redirect.usacloud.jp"
+  redirect_status_code = "301"
+
+  depends_on = [sakura_enhanced_lb_rule.www]
+}
+
+resource "sakura_enhanced_lb_rule" "catch_all" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  host           = "usacloud.jp"
+  group          = "group1"
+
+  depends_on = [sakura_enhanced_lb_rule.api]
+}
+`
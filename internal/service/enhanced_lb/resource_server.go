@@ -0,0 +1,284 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package enhanced_lb
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type enhancedLBServerResource struct {
+	client *common.APIClient
+}
+
+var (
+	_ resource.Resource              = &enhancedLBServerResource{}
+	_ resource.ResourceWithConfigure = &enhancedLBServerResource{}
+)
+
+func NewEnhancedLBServerResource() resource.Resource {
+	return &enhancedLBServerResource{}
+}
+
+func (r *enhancedLBServerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enhanced_lb_server"
+}
+
+func (r *enhancedLBServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient
+}
+
+type enhancedLBServerResourceModel struct {
+	enhancedLBServerModel
+	ID           types.String   `tfsdk:"id"`
+	EnhancedLBID types.String   `tfsdk:"enhanced_lb_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *enhancedLBServerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("Enhanced LB Server"),
+			"enhanced_lb_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The id of the Enhanced LB that the server is added to",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip_address": schema.StringAttribute{
+				Required:    true,
+				Description: "The IP address of the destination server",
+				Validators: []validator.String{
+					sacloudvalidator.IPAddressValidator(sacloudvalidator.Both),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"port": schema.Int32Attribute{
+				Required:    true,
+				Description: desc.Sprintf("The port number of the destination server. %s", desc.Range(1, 65535)),
+				Validators: []validator.Int32{
+					int32validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The name of load balancing group. This is used when using rule-based load balancing. %s", desc.Length(1, 10)),
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 10),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The flag to enable as destination of load balancing",
+				Default:     booldefault.StaticBool(true),
+			},
+			"tls_enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The flag to enable TLS/SSL for communication with the destination server",
+				Default:     booldefault.StaticBool(false),
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+		MarkdownDescription: "Manages a destination server of an Enhanced Load Balancer. Servers not managed by this resource are left untouched.\n\n~> **Note:** Set `manage_servers = false` in `sakura_enhanced_lb` for the same Enhanced LB, otherwise both resources will overwrite each other.",
+	}
+}
+
+func (r *enhancedLBServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan enhancedLBServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := plan.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("Enhanced LB[%s] is not found", elbID))
+		}
+		return
+	}
+
+	server := expandEnhancedLBServer(&plan.enhancedLBServerModel)
+	if findEnhancedLBServer(elb.Servers, server.IPAddress, server.Port) >= 0 {
+		resp.Diagnostics.AddError("Create: Conflict Error",
+			fmt.Sprintf("server %s already exists in Enhanced LB[%s]", enhancedLBServerAddress(server.IPAddress, server.Port), elbID))
+		return
+	}
+
+	settings := expandEnhancedLBUpdateSettingsRequest(elb)
+	settings.Servers = append(settings.Servers, server)
+	elb, err := iaas.NewProxyLBOp(r.client).UpdateSettings(ctx, elb.ID, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to add server to Enhanced LB[%s]: %s", elbID, err))
+		return
+	}
+
+	if !plan.updateState(elb) {
+		resp.Diagnostics.AddError("Create: Terraform Error", fmt.Sprintf("server %s is not found in Enhanced LB[%s] after adding", enhancedLBServerAddress(server.IPAddress, server.Port), elbID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *enhancedLBServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state enhancedLBServerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	elb := getELB(ctx, r.client, state.EnhancedLBID.ValueString(), &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	if !state.updateState(elb) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *enhancedLBServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan enhancedLBServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := plan.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	server := expandEnhancedLBServer(&plan.enhancedLBServerModel)
+	settings := expandEnhancedLBUpdateSettingsRequest(elb)
+	idx := findEnhancedLBServer(settings.Servers, server.IPAddress, server.Port)
+	if idx < 0 {
+		resp.Diagnostics.AddError("Update: API Error",
+			fmt.Sprintf("server %s is not found in Enhanced LB[%s]", enhancedLBServerAddress(server.IPAddress, server.Port), elbID))
+		return
+	}
+	settings.Servers[idx] = server
+
+	elb, err := iaas.NewProxyLBOp(r.client).UpdateSettings(ctx, elb.ID, settings)
+	if err != nil {
+		resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("failed to update server of Enhanced LB[%s]: %s", elbID, err))
+		return
+	}
+
+	if !plan.updateState(elb) {
+		resp.Diagnostics.AddError("Update: Terraform Error", fmt.Sprintf("server %s is not found in Enhanced LB[%s] after updating", enhancedLBServerAddress(server.IPAddress, server.Port), elbID))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *enhancedLBServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state enhancedLBServerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	elbID := state.EnhancedLBID.ValueString()
+	common.SakuraMutexKV.Lock(elbID)
+	defer common.SakuraMutexKV.Unlock(elbID)
+
+	elb := getELB(ctx, r.client, elbID, &resp.State, &resp.Diagnostics)
+	if elb == nil {
+		return
+	}
+
+	settings := expandEnhancedLBUpdateSettingsRequest(elb)
+	idx := findEnhancedLBServer(settings.Servers, state.IPAddress.ValueString(), int(state.Port.ValueInt32()))
+	if idx < 0 {
+		return
+	}
+	settings.Servers = append(settings.Servers[:idx:idx], settings.Servers[idx+1:]...)
+
+	if _, err := iaas.NewProxyLBOp(r.client).UpdateSettings(ctx, elb.ID, settings); err != nil {
+		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to remove server from Enhanced LB[%s]: %s", elbID, err))
+		return
+	}
+}
+
+// updateState はEnhanced LBからIPアドレスとポートが一致するサーバを探してstateに反映する。見つからない場合はfalseを返す
+func (model *enhancedLBServerResourceModel) updateState(elb *iaas.ProxyLB) bool {
+	idx := findEnhancedLBServer(elb.Servers, model.IPAddress.ValueString(), int(model.Port.ValueInt32()))
+	if idx < 0 {
+		return false
+	}
+
+	model.enhancedLBServerModel = flattenEnhancedLBServer(elb.Servers[idx])
+	model.ID = types.StringValue(elb.ID.String() + "/" + enhancedLBServerAddress(model.IPAddress.ValueString(), int(model.Port.ValueInt32())))
+	model.EnhancedLBID = types.StringValue(elb.ID.String())
+	return true
+}
+
+func findEnhancedLBServer(servers []*iaas.ProxyLBServer, ipAddress string, port int) int {
+	for i, s := range servers {
+		if s != nil && s.IPAddress == ipAddress && s.Port == port {
+			return i
+		}
+	}
+	return -1
+}
+
+func enhancedLBServerAddress(ipAddress string, port int) string {
+	return net.JoinHostPort(ipAddress, strconv.Itoa(port))
+}
@@ -0,0 +1,107 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package enhanced_lb_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraEnhancedLBServer_basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envEnhancedLBRealServerIP0, envEnhancedLBRealServerIP1)
+
+	rand := test.RandomName()
+	ip0 := os.Getenv(envEnhancedLBRealServerIP0)
+	ip1 := os.Getenv(envEnhancedLBRealServerIP1)
+
+	var elb iaas.ProxyLB
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraEnhancedLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBServer_basic, rand, ip0, ip1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraEnhancedLBExists("sakura_enhanced_lb.foobar", &elb),
+					testCheckSakuraEnhancedLBServerCount(&elb, 2),
+					resource.TestCheckNoResourceAttr("sakura_enhanced_lb.foobar", "server"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar0", "ip_address", ip0),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar0", "port", "80"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar0", "group", "group1"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar0", "enabled", "true"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar0", "tls_enabled", "false"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar1", "ip_address", ip1),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar1", "group", ""),
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLBServer_update, rand, ip0, ip1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraEnhancedLBExists("sakura_enhanced_lb.foobar", &elb),
+					testCheckSakuraEnhancedLBServerCount(&elb, 1),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar0", "group", "group2"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar0", "enabled", "false"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_server.foobar0", "tls_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSakuraEnhancedLBServerCount(elb *iaas.ProxyLB, expected int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if len(elb.Servers) != expected {
+			return fmt.Errorf("unexpected number of servers in Enhanced LB[%s]: expected: %d, actual: %d", elb.ID, expected, len(elb.Servers))
+		}
+		return nil
+	}
+}
+
+var testAccSakuraEnhancedLBServer_base = `
+resource "sakura_enhanced_lb" "foobar" {
+  name           = "{{ .arg0 }}"
+  manage_servers = false
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "http"
+    port       = 80
+  }]
+}
+`
+
+var testAccSakuraEnhancedLBServer_basic = testAccSakuraEnhancedLBServer_base + `
+resource "sakura_enhanced_lb_server" "foobar0" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  ip_address     = "{{ .arg1 }}"
+  port           = 80
+  group          = "group1"
+}
+
+resource "sakura_enhanced_lb_server" "foobar1" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  ip_address     = "{{ .arg2 }}"
+  port           = 80
+}
+`
+
+var testAccSakuraEnhancedLBServer_update = testAccSakuraEnhancedLBServer_base + `
+resource "sakura_enhanced_lb_server" "foobar0" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  ip_address     = "{{ .arg1 }}"
+  port           = 80
+  group          = "group2"
+  enabled        = false
+  tls_enabled    = true
+}
+`
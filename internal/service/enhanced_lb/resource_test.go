@@ -162,6 +162,86 @@ func(state *terraform.State) error {
 	})
 }
 
+func TestAccSakuraEnhancedLB_certificateSource(t *testing.T) {
+	resourceName := "sakura_enhanced_lb.foobar"
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraEnhancedLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLB_certificateSource, rand, "rsa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "certificate.common_name", "example.sakura.ne.jp"),
+					resource.TestCheckResourceAttr(resourceName, "certificate.source_version", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.expires_at"),
+				),
+			},
+			{
+				// シークレットの更新は次のplanで検出されるため、このステップでは証明書は更新されない
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLB_certificateSource, rand, "ecdsa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "certificate.source_version", "1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLB_certificateSource, rand, "ecdsa"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "certificate.source_version", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate.expires_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSakuraEnhancedLB_manageCertificates(t *testing.T) {
+	resourceName := "sakura_enhanced_lb.foobar"
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraEnhancedLBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLB_manageCertificates, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manage_certificates", "true"),
+					resource.TestCheckResourceAttr(resourceName, "certificate.common_name", "example.sakura.ne.jp"),
+				),
+			},
+			{
+				// manage_certificatesがtrueの場合、certificateを削除すると証明書も削除される
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLB_manageCertificatesRemoved, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manage_certificates", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "certificate.common_name"),
+				),
+			},
+			{
+				// manage_certificatesがfalseの場合、sakura_enhanced_lb_certificateの証明書は変更されない
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLB_manageCertificatesDisabled, rand, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manage_certificates", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "certificate.common_name"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_certificate.foobar", "common_name", "example.sakura.ne.jp"),
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEnhancedLB_manageCertificatesDisabled, rand, "description-upd"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description-upd"),
+					resource.TestCheckResourceAttr("sakura_enhanced_lb_certificate.foobar", "common_name", "example.sakura.ne.jp"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckSakuraEnhancedLBExists(n string, elb *iaas.ProxyLB) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -480,3 +560,94 @@ func TestAccImportSakuraEnhancedLB_basic(t *testing.T) {
   tags        = ["tag1", "tag2"]
 }
 `
+
+var testAccSakuraEnhancedLB_certificateSource = `
+resource "sakura_kms" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_secret_manager" "foobar" {
+  name       = "{{ .arg0 }}"
+  kms_key_id = sakura_kms.foobar.id
+}
+
+resource "sakura_secret_manager_secret" "foobar" {
+  name     = "{{ .arg0 }}"
+  vault_id = sakura_secret_manager.foobar.id
+  value    = join("", [file("testdata/{{ .arg1 }}.crt"), file("testdata/{{ .arg1 }}.key")])
+}
+
+resource "sakura_enhanced_lb" "foobar" {
+  name = "{{ .arg0 }}"
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "https"
+    port       = 443
+  }]
+  certificate = {
+    source = {
+      secret_manager = {
+        vault_id = sakura_secret_manager_secret.foobar.vault_id
+        name     = sakura_secret_manager_secret.foobar.name
+      }
+    }
+  }
+}
+`
+
+var testAccSakuraEnhancedLB_manageCertificates = `
+resource "sakura_enhanced_lb" "foobar" {
+  name = "{{ .arg0 }}"
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "https"
+    port       = 443
+  }]
+  certificate = {
+    server_cert = file("testdata/rsa.crt")
+    private_key = file("testdata/rsa.key")
+  }
+}
+`
+
+var testAccSakuraEnhancedLB_manageCertificatesRemoved = `
+resource "sakura_enhanced_lb" "foobar" {
+  name = "{{ .arg0 }}"
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "https"
+    port       = 443
+  }]
+}
+`
+
+var testAccSakuraEnhancedLB_manageCertificatesDisabled = `
+resource "sakura_enhanced_lb" "foobar" {
+  name                = "{{ .arg0 }}"
+  manage_certificates = false
+  health_check = {
+    protocol   = "tcp"
+    delay_loop = 20
+  }
+  bind_port = [{
+    proxy_mode = "https"
+    port       = 443
+  }]
+  description = "{{ .arg1 }}"
+}
+
+resource "sakura_enhanced_lb_certificate" "foobar" {
+  enhanced_lb_id = sakura_enhanced_lb.foobar.id
+  server_cert    = file("testdata/rsa.crt")
+  private_key    = file("testdata/rsa.key")
+}
+`
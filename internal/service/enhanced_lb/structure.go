@@ -5,6 +5,9 @@
 
 import (
 	"context"
+	"encoding/pem"
+	"errors"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/sacloud/iaas-api-go"
@@ -190,59 +193,57 @@ func expandEnhancedLBSorryServer(model *enhancedLBResourceModel) *iaas.ProxyLBSo
 func expandEnhancedLBServers(model *enhancedLBResourceModel) []*iaas.ProxyLBServer {
 	var results []*iaas.ProxyLBServer
 	if len(model.Server) > 0 {
-		for _, server := range model.Server {
-			results = append(results, &iaas.ProxyLBServer{
-				IPAddress:   server.IPAddress.ValueString(),
-				Port:        int(server.Port.ValueInt32()),
-				Enabled:     server.Enabled.ValueBool(),
-				ServerGroup: server.Group.ValueString(),
-				ProxySSL:    server.TLSEnabled.ValueBool(),
-			})
+		for i := range model.Server {
+			results = append(results, expandEnhancedLBServer(&model.Server[i]))
 		}
 	}
 	return results
 }
 
+func expandEnhancedLBServer(server *enhancedLBServerModel) *iaas.ProxyLBServer {
+	return &iaas.ProxyLBServer{
+		IPAddress:   server.IPAddress.ValueString(),
+		Port:        int(server.Port.ValueInt32()),
+		Enabled:     server.Enabled.ValueBool(),
+		ServerGroup: server.Group.ValueString(),
+		ProxySSL:    server.TLSEnabled.ValueBool(),
+	}
+}
+
 func expandEnhancedLBRules(model *enhancedLBResourceModel) []*iaas.ProxyLBRule {
 	var results []*iaas.ProxyLBRule
 	if len(model.Rule) > 0 {
-		for _, rule := range model.Rule {
-			results = append(results, &iaas.ProxyLBRule{
-				Host:                         rule.Host.ValueString(),
-				Path:                         rule.Path.ValueString(),
-				SourceIPs:                    rule.SourceIPs.ValueString(),
-				RequestHeaderName:            rule.RequestHeaderName.ValueString(),
-				RequestHeaderValue:           rule.RequestHeaderValue.ValueString(),
-				RequestHeaderValueIgnoreCase: rule.RequestHeaderValueIgnoreCase.ValueBool(),
-				RequestHeaderValueNotMatch:   rule.RequestHeaderValueNotMatch.ValueBool(),
-				ServerGroup:                  rule.Group.ValueString(),
-				Action:                       iaastypes.EProxyLBRuleAction(rule.Action.ValueString()),
-				RedirectLocation:             rule.RedirectLocation.ValueString(),
-				RedirectStatusCode:           iaastypes.EProxyLBRedirectStatusCode(utils.MustAtoI(rule.RedirectStatusCode.ValueString())),
-				FixedStatusCode:              iaastypes.EProxyLBFixedStatusCode(utils.MustAtoI(rule.FixedStatusCode.ValueString())),
-				FixedContentType:             iaastypes.EProxyLBFixedContentType(rule.FixedContentType.ValueString()),
-				FixedMessageBody:             rule.FixedMessageBody.ValueString(),
-			})
+		for i := range model.Rule {
+			results = append(results, expandEnhancedLBRule(&model.Rule[i]))
 		}
 	}
 	return results
 }
 
+func expandEnhancedLBRule(rule *enhancedLBRuleModel) *iaas.ProxyLBRule {
+	return &iaas.ProxyLBRule{
+		Host:                         rule.Host.ValueString(),
+		Path:                         rule.Path.ValueString(),
+		SourceIPs:                    rule.SourceIPs.ValueString(),
+		RequestHeaderName:            rule.RequestHeaderName.ValueString(),
+		RequestHeaderValue:           rule.RequestHeaderValue.ValueString(),
+		RequestHeaderValueIgnoreCase: rule.RequestHeaderValueIgnoreCase.ValueBool(),
+		RequestHeaderValueNotMatch:   rule.RequestHeaderValueNotMatch.ValueBool(),
+		ServerGroup:                  rule.Group.ValueString(),
+		Action:                       iaastypes.EProxyLBRuleAction(rule.Action.ValueString()),
+		RedirectLocation:             rule.RedirectLocation.ValueString(),
+		RedirectStatusCode:           iaastypes.EProxyLBRedirectStatusCode(utils.MustAtoI(rule.RedirectStatusCode.ValueString())),
+		FixedStatusCode:              iaastypes.EProxyLBFixedStatusCode(utils.MustAtoI(rule.FixedStatusCode.ValueString())),
+		FixedContentType:             iaastypes.EProxyLBFixedContentType(rule.FixedContentType.ValueString()),
+		FixedMessageBody:             rule.FixedMessageBody.ValueString(),
+	}
+}
+
 func expandEnhancedLBTimeout(model *enhancedLBResourceModel) *iaas.ProxyLBTimeout {
 	return &iaas.ProxyLBTimeout{InactiveSec: int(model.Timeout.ValueInt64())}
 }
 
-func expandEnhancedLBCerts(model *enhancedLBResourceModel) *iaas.ProxyLBCertificates {
-	if model.Certificate.IsNull() || model.Certificate.IsUnknown() {
-		return nil
-	}
-
-	var cm enhancedLBCertificateModel
-	diags := model.Certificate.As(context.Background(), &cm, basetypes.ObjectAsOptions{})
-	if diags.HasError() {
-		return nil
-	}
-
+func expandEnhancedLBCerts(cm *enhancedLBResourceCertificateModel) *iaas.ProxyLBCertificates {
 	cert := &iaas.ProxyLBCertificates{
 		PrimaryCert: &iaas.ProxyLBPrimaryCert{
 			ServerCertificate:       cm.ServerCert.ValueString(),
@@ -287,3 +288,71 @@ func expandEnhancedLBACMESetting(plan, state *enhancedLBResourceModel) *iaas.Pro
 		SubjectAltNames: common.TsetToStrings(lm.SubjectAltNames),
 	}
 }
+
+// expandEnhancedLBUpdateSettingsRequest は現在の設定をそのまま引き継いだ設定更新リクエストを返す。
+// サーバやルールなど一部の設定のみを管理するリソースから利用する
+func expandEnhancedLBUpdateSettingsRequest(elb *iaas.ProxyLB) *iaas.ProxyLBUpdateSettingsRequest {
+	return &iaas.ProxyLBUpdateSettingsRequest{
+		HealthCheck:          elb.HealthCheck,
+		SorryServer:          elb.SorryServer,
+		BindPorts:            elb.BindPorts,
+		Servers:              elb.Servers,
+		Rules:                elb.Rules,
+		LetsEncrypt:          elb.LetsEncrypt,
+		StickySession:        elb.StickySession,
+		Timeout:              elb.Timeout,
+		Gzip:                 elb.Gzip,
+		BackendHttpKeepAlive: elb.BackendHttpKeepAlive,
+		MonitoringSuiteLog:   elb.MonitoringSuiteLog,
+		ProxyProtocol:        elb.ProxyProtocol,
+		Syslog:               elb.Syslog,
+		OriginGuard:          elb.OriginGuard,
+		StrictRule:           elb.StrictRule,
+		SettingsHash:         elb.SettingsHash,
+	}
+}
+
+// expandEnhancedLBCertificateBundle はPEM形式の証明書バンドルをサーバ証明書、中間証明書、秘密鍵に分割する。
+// 最初の証明書をサーバ証明書、以降の証明書を中間証明書として扱う
+func expandEnhancedLBCertificateBundle(bundle string) (*iaas.ProxyLBPrimaryCert, error) {
+	var serverCert, privateKey string
+	var intermediateCerts []string
+
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		encoded := string(pem.EncodeToMemory(block))
+		switch {
+		case block.Type == "CERTIFICATE":
+			if serverCert == "" {
+				serverCert = encoded
+			} else {
+				intermediateCerts = append(intermediateCerts, encoded)
+			}
+		case block.Type == "ENCRYPTED PRIVATE KEY":
+			return nil, errors.New("encrypted private key is not supported")
+		case strings.HasSuffix(block.Type, "PRIVATE KEY"):
+			if privateKey != "" {
+				return nil, errors.New("multiple private keys are found")
+			}
+			privateKey = encoded
+		}
+	}
+
+	if serverCert == "" {
+		return nil, errors.New("no certificate is found")
+	}
+	if privateKey == "" {
+		return nil, errors.New("no private key is found")
+	}
+	return &iaas.ProxyLBPrimaryCert{
+		ServerCertificate:       serverCert,
+		IntermediateCertificate: strings.Join(intermediateCerts, ""),
+		PrivateKey:              privateKey,
+	}, nil
+}
@@ -0,0 +1,253 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iam-api-go"
+	"github.com/sacloud/iam-api-go/apis/group"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+)
+
+const (
+	principalTypeUser  = "user"
+	principalTypeGroup = "group"
+)
+
+type effectivePermissionsDataSource struct {
+	client *v1.Client
+}
+
+var (
+	_ datasource.DataSource                   = &effectivePermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &effectivePermissionsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &effectivePermissionsDataSource{}
+)
+
+func NewEffectivePermissionsDataSource() datasource.DataSource {
+	return &effectivePermissionsDataSource{}
+}
+
+func (d *effectivePermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_effective_permissions"
+}
+
+func (d *effectivePermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.client = apiclient.IamClient
+}
+
+func (d *effectivePermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	targetAttrs := func() map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"target": schema.StringAttribute{
+				Computed:    true,
+				Description: "The target where the role is granted",
+			},
+			"target_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the target. This is null for organization",
+			},
+		}
+	}
+	grantAttrs := targetAttrs()
+	grantAttrs["role_type"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The type of the role",
+	}
+	grantAttrs["role_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The ID of the role",
+	}
+	grantAttrs["principal_type"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The type of the principal granted the role. This is `group` when the role is granted via the group",
+	}
+	grantAttrs["principal_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "The ID of the principal granted the role",
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"principal": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The principal to resolve the roles",
+				Attributes:  schemaDataSourcePolicyPrincipalAttributes,
+			},
+			"target": schema.StringAttribute{
+				Required:    true,
+				Description: desc.Sprintf("The target to resolve the roles. This must be one of %s.", []string{targetProject, targetFolder, targetOrg}),
+				Validators: []validator.String{
+					stringvalidator.OneOf(targetProject, targetFolder, targetOrg),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the target. Required for Folder or Project",
+			},
+			"include_groups": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to include the roles granted to the groups which the user belongs to. Only available when the type of the principal is `user`",
+			},
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "The IDs of the roles effective on the target",
+			},
+			"grants": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The grants of the roles, ordered from the target to the organization",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: grantAttrs,
+				},
+			},
+			"hierarchy": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The resolved hierarchy from the target to the organization",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: targetAttrs(),
+				},
+			},
+		},
+		MarkdownDescription: "Resolves the roles of a principal effective on a project, folder or organization, including the roles inherited from the parent folders and the organization.\n\nThis data source reads the IAM Policies only, and the service policies are not evaluated.",
+	}
+}
+
+func (d *effectivePermissionsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data policyEffectivePermissionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Target.ValueString() != targetOrg && utils.IsKnown(data.Target) && data.TargetID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("target_id"), "Missing Attribute", fmt.Sprintf("target_id is required for %s", data.Target.ValueString()))
+	}
+	if data.IncludeGroups.ValueBool() && data.Principal != nil && utils.IsKnown(data.Principal.Type) && data.Principal.Type.ValueString() != principalTypeUser {
+		resp.Diagnostics.AddAttributeError(path.Root("include_groups"), "Invalid Attribute Combination",
+			fmt.Sprintf("include_groups is only available when the type of the principal is %q", principalTypeUser))
+	}
+}
+
+func (d *effectivePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data policyEffectivePermissionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principals := []v1.Principal{expandIAMPolicyPrincipal(data.Principal)}
+	if data.IncludeGroups.ValueBool() {
+		groups, err := d.listUserGroups(ctx, utils.MustAtoI(data.Principal.ID.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddError("Read: API Error", err.Error())
+			return
+		}
+		principals = append(principals, groups...)
+	}
+
+	hierarchy, err := d.resolveHierarchy(ctx, data.Target.ValueString(), data.TargetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Read: API Error", err.Error())
+		return
+	}
+
+	grants := []policyEffectiveGrant{}
+	roles := []string{}
+	for _, level := range hierarchy {
+		bindings, err := readIAMPolicy(ctx, d.client, level.Target.ValueString(), level.TargetID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Read: API Error", err.Error())
+			return
+		}
+		for _, g := range collectEffectiveGrants(level, bindings, principals) {
+			grants = append(grants, g)
+			roles = append(roles, g.RoleID.ValueString())
+		}
+	}
+
+	slices.Sort(roles)
+	rolesValue, diags := types.SetValueFrom(ctx, types.StringType, slices.Compact(roles))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Roles = rolesValue
+	data.Grants = grants
+	data.Hierarchy = hierarchy
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resolveHierarchy returns the targets from the given target to the organization via the parent folders
+func (d *effectivePermissionsDataSource) resolveHierarchy(ctx context.Context, target, targetID string) ([]policyHierarchyTarget, error) {
+	var hierarchy []policyHierarchyTarget
+	var parent v1.NilInt
+	switch target {
+	case targetProject:
+		project, err := iam.NewProjectOp(d.client).Read(ctx, utils.MustAtoI(targetID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read IAM Project[%s]: %s", targetID, err)
+		}
+		hierarchy = append(hierarchy, policyHierarchyTarget{Target: types.StringValue(targetProject), TargetID: types.StringValue(targetID)})
+		parent = project.ParentFolderID
+	case targetFolder:
+		parent = v1.NewNilInt(utils.MustAtoI(targetID))
+	}
+
+	folderOp := iam.NewFolderOp(d.client)
+	visited := make(map[int]bool)
+	for !parent.IsNull() {
+		id := parent.Value
+		if visited[id] {
+			return nil, fmt.Errorf("circular reference is detected in the folder hierarchy: %d", id)
+		}
+		visited[id] = true
+
+		folder, err := folderOp.Read(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read IAM Folder[%d]: %s", id, err)
+		}
+		hierarchy = append(hierarchy, policyHierarchyTarget{Target: types.StringValue(targetFolder), TargetID: types.StringValue(strconv.Itoa(id))})
+		parent = folder.ParentID
+	}
+
+	hierarchy = append(hierarchy, policyHierarchyTarget{Target: types.StringValue(targetOrg), TargetID: types.StringNull()})
+	return hierarchy, nil
+}
+
+func (d *effectivePermissionsDataSource) listUserGroups(ctx context.Context, userID int) ([]v1.Principal, error) {
+	groupOp := iam.NewGroupOp(d.client)
+	perPage := 100
+	var principals []v1.Principal
+	for page := 1; ; page++ {
+		groups, err := groupOp.List(ctx, group.ListParams{Page: &page, PerPage: &perPage, User: &v1.User{ID: userID}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list IAM Groups of the user[%d]: %s", userID, err)
+		}
+		for _, g := range groups.Items {
+			principals = append(principals, v1.Principal{Type: v1.NewOptString(principalTypeGroup), ID: v1.NewOptInt(g.ID)})
+		}
+		if len(groups.Items) == 0 || page*perPage >= groups.Count {
+			break
+		}
+	}
+	return principals, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDataSourceIAMEffectivePermissions_Basic(t *testing.T) {
+	test.SkipIfIAMEnvIsNotSet(t)
+
+	resourceName := "data.sakura_iam_effective_permissions.foobar"
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraIAMProjectDestroy,
+			testCheckSakuraIAMFolderDestroy,
+			testCheckSakuraIAMServicePrincipalDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccCheckSakuraDataSourceIAMEffectivePermissionsConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.0.target", "project"),
+					resource.TestCheckResourceAttrPair(resourceName, "hierarchy.0.target_id", "sakura_iam_project.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.1.target", "folder"),
+					resource.TestCheckResourceAttrPair(resourceName, "hierarchy.1.target_id", "sakura_iam_folder.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.2.target", "organization"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "viewer"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "owner"),
+					resource.TestCheckResourceAttr(resourceName, "grants.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "grants.0.role_id", "owner"),
+					resource.TestCheckResourceAttr(resourceName, "grants.0.target", "project"),
+					resource.TestCheckResourceAttr(resourceName, "grants.1.role_id", "viewer"),
+					resource.TestCheckResourceAttr(resourceName, "grants.1.target", "folder"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCheckSakuraDataSourceIAMEffectivePermissionsConfig = `
+resource "sakura_iam_folder" "foobar" {
+  name = "{{ .arg0 }}"
+  description = "description"
+}
+
+resource "sakura_iam_project" "foobar" {
+  name = "{{ .arg0 }}"
+  code = "{{ .arg0 }}"
+  description = "description"
+  parent_folder_id = sakura_iam_folder.foobar.id
+}
+
+resource "sakura_iam_service_principal" "foobar" {
+  name = "{{ .arg0 }}"
+  description = "description"
+  project_id = sakura_iam_project.foobar.id
+}
+
+resource "sakura_iam_policy_member" "project" {
+  target    = "project"
+  target_id = sakura_iam_project.foobar.id
+  role = {
+    id   = "owner"
+    type = "preset"
+  }
+  principal = {
+    id   = sakura_iam_service_principal.foobar.id
+    type = "service-principal"
+  }
+}
+
+resource "sakura_iam_policy_member" "folder" {
+  target    = "folder"
+  target_id = sakura_iam_folder.foobar.id
+  role = {
+    id   = "viewer"
+    type = "preset"
+  }
+  principal = {
+    id   = sakura_iam_service_principal.foobar.id
+    type = "service-principal"
+  }
+}
+
+data "sakura_iam_effective_permissions" "foobar" {
+  principal = {
+    id   = sakura_iam_service_principal.foobar.id
+    type = "service-principal"
+  }
+  target    = "project"
+  target_id = sakura_iam_project.foobar.id
+
+  depends_on = [sakura_iam_policy_member.project, sakura_iam_policy_member.folder]
+}`
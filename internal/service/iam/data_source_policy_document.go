@@ -0,0 +1,206 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iam-api-go"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/sacloud/saclient-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type policyDocumentDataSource struct {
+	client *v1.Client
+}
+
+var (
+	_ datasource.DataSource              = &policyDocumentDataSource{}
+	_ datasource.DataSourceWithConfigure = &policyDocumentDataSource{}
+)
+
+func NewPolicyDocumentDataSource() datasource.DataSource {
+	return &policyDocumentDataSource{}
+}
+
+func (d *policyDocumentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_policy_document"
+}
+
+func (d *policyDocumentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.client = apiclient.IamClient
+}
+
+var schemaDataSourcePolicyPrincipalAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{
+		Required:    true,
+		Description: "The type of the principal",
+	},
+	"id": schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the principal",
+		Validators: []validator.String{
+			sacloudvalidator.SakuraIDValidator(),
+		},
+	},
+}
+
+func (d *policyDocumentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"policy_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: desc.Sprintf("The type of the policy. `%s` validates the roles with IAM Roles for `sakura_iam_policy`, and `%s` validates them with ID Roles for `sakura_iam_organization_id_policy`. Default is `%s`.",
+					policyDocumentTypeIAM, policyDocumentTypeID, policyDocumentTypeIAM),
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyDocumentTypeIAM, policyDocumentTypeID),
+				},
+			},
+			"target": schema.StringAttribute{
+				Optional:    true,
+				Description: desc.Sprintf("The target which the policy is applied to. When specified, the roles are checked whether they can be granted on the target. This must be one of %s. Only available with `%s` policy_type.", []string{targetProject, targetFolder, targetOrg}, policyDocumentTypeIAM),
+				Validators: []validator.String{
+					stringvalidator.OneOf(targetProject, targetFolder, targetOrg),
+				},
+			},
+			"binding": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The bindings to assemble. The bindings of the same role are merged into one.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the role",
+						},
+						"role_type": schema.StringAttribute{
+							Optional:    true,
+							Description: desc.Sprintf("The type of the role. Default is `%s`.", string(v1.IamPolicyRoleTypePreset)),
+						},
+						"principals": schema.ListNestedAttribute{
+							Required:    true,
+							Description: "The principals granted the role",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: schemaDataSourcePolicyPrincipalAttributes,
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"bindings": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The assembled bindings, which can be passed to `bindings` of `sakura_iam_policy` or `sakura_iam_organization_id_policy`",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "The role of the binding",
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									Computed:    true,
+									Description: "The type of the role",
+								},
+								"id": schema.StringAttribute{
+									Computed:    true,
+									Description: "The ID of the role",
+								},
+							},
+						},
+						"principals": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The principals of the binding",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Computed:    true,
+										Description: "The type of the principal",
+									},
+									"id": schema.StringAttribute{
+										Computed:    true,
+										Description: "The ID of the principal",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		MarkdownDescription: "Assembles the bindings of an IAM Policy from HCL, and validates the role IDs with IAM Roles or ID Roles.",
+	}
+}
+
+func (d *policyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data policyDocumentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyType := policyDocumentTypeIAM
+	if data.PolicyType.ValueString() != "" {
+		policyType = data.PolicyType.ValueString()
+	}
+	if policyType != policyDocumentTypeIAM && data.Target.ValueString() != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("target"), "Invalid Attribute Combination",
+			fmt.Sprintf("target is only available with %q policy_type", policyDocumentTypeIAM))
+		return
+	}
+
+	bindings := buildPolicyDocument(data.Binding)
+	for i, b := range bindings {
+		if err := d.validateRole(ctx, policyType, b.Role.ID.ValueString(), data.Target.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("binding"), "Read: Invalid Role", fmt.Sprintf("bindings[%d]: %s", i, err))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.PolicyType = types.StringValue(policyType)
+	data.Bindings = bindings
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *policyDocumentDataSource) validateRole(ctx context.Context, policyType, roleID, target string) error {
+	if policyType == policyDocumentTypeID {
+		if _, err := iam.NewIDRoleOp(d.client).Read(ctx, roleID); err != nil {
+			if saclient.IsNotFoundError(err) {
+				return fmt.Errorf("ID Role %q is not found", roleID)
+			}
+			return fmt.Errorf("failed to read ID Role[%s]: %s", roleID, err)
+		}
+		return nil
+	}
+
+	role, err := iam.NewIAMRoleOp(d.client).Read(ctx, roleID)
+	if err != nil {
+		if saclient.IsNotFoundError(err) {
+			return fmt.Errorf("IAM Role %q is not found", roleID)
+		}
+		return fmt.Errorf("failed to read IAM Role[%s]: %s", roleID, err)
+	}
+	if target != "" {
+		return checkIAMRoleGrantable(role, target)
+	}
+	return nil
+}
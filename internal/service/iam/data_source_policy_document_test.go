@@ -0,0 +1,128 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDataSourceIAMPolicyDocument_Basic(t *testing.T) {
+	test.SkipIfIAMEnvIsNotSet(t)
+
+	resourceName := "data.sakura_iam_policy_document.foobar"
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraIAMProjectDestroy,
+			testCheckSakuraIAMServicePrincipalDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccCheckSakuraDataSourceIAMPolicyDocumentConfig, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_type", "iam"),
+					resource.TestCheckResourceAttr(resourceName, "bindings.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bindings.0.role.id", "owner"),
+					resource.TestCheckResourceAttr(resourceName, "bindings.0.role.type", "preset"),
+					resource.TestCheckResourceAttr(resourceName, "bindings.0.principals.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bindings.1.role.id", "viewer"),
+					resource.TestCheckResourceAttr(resourceName, "bindings.1.principals.#", "1"),
+					resource.TestCheckResourceAttr("sakura_iam_policy.foobar", "bindings.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSakuraDataSourceIAMPolicyDocument_InvalidRole(t *testing.T) {
+	test.SkipIfIAMEnvIsNotSet(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckSakuraDataSourceIAMPolicyDocumentConfig_invalidRole,
+				ExpectError: regexp.MustCompile(`IAM Role "not-exist-role" is not found`),
+			},
+		},
+	})
+}
+
+var testAccCheckSakuraDataSourceIAMPolicyDocumentConfig = `
+resource "sakura_iam_project" "foobar" {
+  name = "{{ .arg0 }}"
+  code = "{{ .arg0 }}"
+  description = "description"
+}
+
+resource "sakura_iam_service_principal" "foobar" {
+  name = "{{ .arg0 }}"
+  description = "description"
+  project_id = sakura_iam_project.foobar.id
+}
+
+resource "sakura_iam_service_principal" "foobar2" {
+  name = "{{ .arg0 }}-2"
+  description = "description"
+  project_id = sakura_iam_project.foobar.id
+}
+
+data "sakura_iam_policy_document" "foobar" {
+  target = "project"
+  binding = [
+    {
+      role = "owner"
+      principals = [{
+        id   = sakura_iam_service_principal.foobar.id
+        type = "service-principal"
+      }]
+    },
+    {
+      role = "viewer"
+      principals = [{
+        id   = sakura_iam_service_principal.foobar.id
+        type = "service-principal"
+      }]
+    },
+    {
+      role      = "owner"
+      role_type = "preset"
+      principals = [
+        {
+          id   = sakura_iam_service_principal.foobar.id
+          type = "service-principal"
+        },
+        {
+          id   = sakura_iam_service_principal.foobar2.id
+          type = "service-principal"
+        },
+      ]
+    },
+  ]
+}
+
+resource "sakura_iam_policy" "foobar" {
+  target    = "project"
+  target_id = sakura_iam_project.foobar.id
+  bindings  = data.sakura_iam_policy_document.foobar.bindings
+}`
+
+var testAccCheckSakuraDataSourceIAMPolicyDocumentConfig_invalidRole = `
+data "sakura_iam_policy_document" "foobar" {
+  binding = [{
+    role = "not-exist-role"
+    principals = [{
+      id   = "123456789012"
+      type = "service-principal"
+    }]
+  }]
+}`
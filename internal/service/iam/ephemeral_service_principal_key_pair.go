@@ -0,0 +1,85 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+)
+
+type servicePrincipalKeyPairEphemeralResource struct{}
+
+var (
+	_ ephemeral.EphemeralResource = &servicePrincipalKeyPairEphemeralResource{}
+)
+
+func NewServicePrincipalKeyPairEphemeralResource() ephemeral.EphemeralResource {
+	return &servicePrincipalKeyPairEphemeralResource{}
+}
+
+func (r *servicePrincipalKeyPairEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_service_principal_key_pair"
+}
+
+type servicePrincipalKeyPairEphemeralModel struct {
+	RSABits       types.Int64  `tfsdk:"rsa_bits"`
+	PrivateKeyPEM types.String `tfsdk:"private_key_pem"`
+	PublicKeyPEM  types.String `tfsdk:"public_key_pem"`
+}
+
+func (r *servicePrincipalKeyPairEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"rsa_bits": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The size of the RSA key in bits. This must be one of [%s]. Default is `%d`.", servicePrincipalKeyBits, servicePrincipalKeyMinBits),
+				Validators: []validator.Int64{
+					int64validator.OneOf(servicePrincipalKeyBits...),
+				},
+			},
+			"private_key_pem": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key in PKCS#8 PEM format. This can be used as `service_private_key` in the provider configuration.",
+			},
+			"public_key_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key in PKIX PEM format. Pass this to `public_key_wo` of `sakura_iam_service_principal_key`.",
+			},
+		},
+		MarkdownDescription: "Generates an RSA key pair for an IAM Service Principal Key.\n\nThe key pair is generated locally and no API request is sent. A new key pair is generated every time the ephemeral resource is opened, so register the public key with `public_key_wo` and store the private key with a write-only attribute in the same apply, bumping both versions together. EC keys are not supported because IAM accepts only RSA keys.",
+	}
+}
+
+func (r *servicePrincipalKeyPairEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data servicePrincipalKeyPairEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bits := int64(servicePrincipalKeyMinBits)
+	if utils.IsKnown(data.RSABits) {
+		bits = data.RSABits.ValueInt64()
+	}
+
+	privateKey, publicKey, err := generateServicePrincipalKeyPair(int(bits))
+	if err != nil {
+		resp.Diagnostics.AddError("Open: Key Generation Error", err.Error())
+		return
+	}
+
+	data.RSABits = types.Int64Value(bits)
+	data.PrivateKeyPEM = types.StringValue(privateKey)
+	data.PublicKeyPEM = types.StringValue(publicKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,157 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+)
+
+type policyBindingBaseModel struct {
+	Target     types.String           `tfsdk:"target"`
+	TargetID   types.String           `tfsdk:"target_id"`
+	Role       *policyRoleModel       `tfsdk:"role"`
+	Principals []policyPrincipalModel `tfsdk:"principals"`
+}
+
+func (model *policyBindingBaseModel) updateState(bindingPrincipals []v1.Principal) {
+	principals := make([]policyPrincipalModel, 0, len(bindingPrincipals))
+	for _, p := range bindingPrincipals {
+		principals = append(principals, flattenIAMPolicyPrincipal(p))
+	}
+	model.Principals = principals
+}
+
+type policyMemberBaseModel struct {
+	Target    types.String          `tfsdk:"target"`
+	TargetID  types.String          `tfsdk:"target_id"`
+	Role      *policyRoleModel      `tfsdk:"role"`
+	Principal *policyPrincipalModel `tfsdk:"principal"`
+}
+
+func expandIAMPolicyRole(role *policyRoleModel) v1.IamPolicyRole {
+	return v1.IamPolicyRole{
+		Type: v1.NewOptIamPolicyRoleType(v1.IamPolicyRoleType(role.Type.ValueString())),
+		ID:   v1.NewOptString(role.ID.ValueString()),
+	}
+}
+
+func expandIAMPolicyPrincipal(p *policyPrincipalModel) v1.Principal {
+	return v1.Principal{
+		Type: v1.NewOptString(p.Type.ValueString()),
+		ID:   v1.NewOptInt(utils.MustAtoI(p.ID.ValueString())),
+	}
+}
+
+func expandIAMPolicyPrincipals(principals []policyPrincipalModel) []v1.Principal {
+	result := make([]v1.Principal, 0, len(principals))
+	for i := range principals {
+		result = append(result, expandIAMPolicyPrincipal(&principals[i]))
+	}
+	return result
+}
+
+func flattenIAMPolicyPrincipal(p v1.Principal) policyPrincipalModel {
+	return policyPrincipalModel{
+		Type: types.StringValue(p.Type.Value),
+		ID:   types.StringValue(strconv.Itoa(p.ID.Value)),
+	}
+}
+
+func isSameIAMPolicyRole(b v1.IamPolicy, role v1.IamPolicyRole) bool {
+	return b.Role.Value.Type.Value == role.Type.Value && b.Role.Value.ID.Value == role.ID.Value
+}
+
+func isSameIAMPolicyPrincipal(p1, p2 v1.Principal) bool {
+	return p1.Type.Value == p2.Type.Value && p1.ID.Value == p2.ID.Value
+}
+
+// 以下はread-modify-writeで使うbindingsの操作。引数のスライスは変更せずに新しいスライスを返す
+
+// collectIAMPolicyPrincipals returns the principals of the role. When the bindings of the role are duplicated,
+// the principals of all of them are returned without duplicates. The second return value is false when the role is not bound.
+func collectIAMPolicyPrincipals(bindings []v1.IamPolicy, role v1.IamPolicyRole) ([]v1.Principal, bool) {
+	var principals []v1.Principal
+	found := false
+	for _, b := range bindings {
+		if !isSameIAMPolicyRole(b, role) {
+			continue
+		}
+		found = true
+		for _, p := range b.Principals {
+			if !slices.ContainsFunc(principals, func(v v1.Principal) bool { return isSameIAMPolicyPrincipal(v, p) }) {
+				principals = append(principals, p)
+			}
+		}
+	}
+	return principals, found
+}
+
+// setIAMPolicyBinding replaces the principals of the role, or appends a new binding when the role is not bound
+func setIAMPolicyBinding(bindings []v1.IamPolicy, role v1.IamPolicyRole, principals []v1.Principal) []v1.IamPolicy {
+	result := make([]v1.IamPolicy, 0, len(bindings)+1)
+	found := false
+	for _, b := range bindings {
+		if isSameIAMPolicyRole(b, role) {
+			if found {
+				continue // 同一ロールのbindingが重複している場合は1つにまとめる
+			}
+			found = true
+			b.Principals = principals
+		}
+		result = append(result, b)
+	}
+	if !found {
+		result = append(result, v1.IamPolicy{Role: v1.NewOptIamPolicyRole(role), Principals: principals})
+	}
+	return result
+}
+
+func removeIAMPolicyBinding(bindings []v1.IamPolicy, role v1.IamPolicyRole) []v1.IamPolicy {
+	return slices.DeleteFunc(slices.Clone(bindings), func(b v1.IamPolicy) bool {
+		return isSameIAMPolicyRole(b, role)
+	})
+}
+
+func hasIAMPolicyMember(bindings []v1.IamPolicy, role v1.IamPolicyRole, principal v1.Principal) bool {
+	for _, b := range bindings {
+		if isSameIAMPolicyRole(b, role) && slices.ContainsFunc(b.Principals, func(p v1.Principal) bool {
+			return isSameIAMPolicyPrincipal(p, principal)
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+func addIAMPolicyMember(bindings []v1.IamPolicy, role v1.IamPolicyRole, principal v1.Principal) []v1.IamPolicy {
+	if hasIAMPolicyMember(bindings, role, principal) {
+		return bindings
+	}
+
+	// 同一ロールのbindingが重複している場合、setIAMPolicyBindingで1つにまとめられるため全てのプリンシパルを引き継ぐ
+	principals, _ := collectIAMPolicyPrincipals(bindings, role)
+	return setIAMPolicyBinding(bindings, role, append(principals, principal))
+}
+
+// removeIAMPolicyMember removes the principal from the role. The binding is removed when it has no principals.
+func removeIAMPolicyMember(bindings []v1.IamPolicy, role v1.IamPolicyRole, principal v1.Principal) []v1.IamPolicy {
+	result := make([]v1.IamPolicy, 0, len(bindings))
+	for _, b := range bindings {
+		if isSameIAMPolicyRole(b, role) {
+			b.Principals = slices.DeleteFunc(slices.Clone(b.Principals), func(p v1.Principal) bool {
+				return isSameIAMPolicyPrincipal(p, principal)
+			})
+			if len(b.Principals) == 0 {
+				continue
+			}
+		}
+		result = append(result, b)
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"testing"
+
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func testIAMPolicyRole(id string) v1.IamPolicyRole {
+	return v1.IamPolicyRole{
+		Type: v1.NewOptIamPolicyRoleType(v1.IamPolicyRoleTypePreset),
+		ID:   v1.NewOptString(id),
+	}
+}
+
+func testIAMPolicyPrincipal(typ string, id int) v1.Principal {
+	return v1.Principal{Type: v1.NewOptString(typ), ID: v1.NewOptInt(id)}
+}
+
+func testIAMPolicy(role string, principals ...v1.Principal) v1.IamPolicy {
+	return v1.IamPolicy{Role: v1.NewOptIamPolicyRole(testIAMPolicyRole(role)), Principals: principals}
+}
+
+func TestSetIAMPolicyBinding(t *testing.T) {
+	user1 := testIAMPolicyPrincipal("user", 1)
+	user2 := testIAMPolicyPrincipal("user", 2)
+	sp1 := testIAMPolicyPrincipal("service-principal", 1)
+	current := []v1.IamPolicy{
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", user1, user2),
+	}
+
+	// 既存のロールは置き換え、他のロールは維持する
+	got := setIAMPolicyBinding(current, testIAMPolicyRole("viewer"), []v1.Principal{sp1})
+	require.Equal(t, []v1.IamPolicy{
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", sp1),
+	}, got)
+
+	got = setIAMPolicyBinding(current, testIAMPolicyRole("editor"), []v1.Principal{sp1})
+	require.Equal(t, []v1.IamPolicy{
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", user1, user2),
+		testIAMPolicy("editor", sp1),
+	}, got)
+
+	got = removeIAMPolicyBinding(current, testIAMPolicyRole("owner"))
+	require.Equal(t, []v1.IamPolicy{testIAMPolicy("viewer", user1, user2)}, got)
+
+	// 引数のbindingsは変更されない
+	require.Equal(t, []v1.IamPolicy{
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", user1, user2),
+	}, current)
+}
+
+func TestIAMPolicyMember(t *testing.T) {
+	user1 := testIAMPolicyPrincipal("user", 1)
+	user2 := testIAMPolicyPrincipal("user", 2)
+	sp1 := testIAMPolicyPrincipal("service-principal", 1)
+	current := []v1.IamPolicy{
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", user1, user2),
+	}
+
+	require.True(t, hasIAMPolicyMember(current, testIAMPolicyRole("viewer"), user2))
+	require.False(t, hasIAMPolicyMember(current, testIAMPolicyRole("owner"), user2))
+	// typeが異なれば別のプリンシパル
+	require.False(t, hasIAMPolicyMember(current, testIAMPolicyRole("owner"), sp1))
+
+	got := addIAMPolicyMember(current, testIAMPolicyRole("owner"), sp1)
+	require.Equal(t, []v1.IamPolicy{
+		testIAMPolicy("owner", user1, sp1),
+		testIAMPolicy("viewer", user1, user2),
+	}, got)
+	require.Equal(t, current, addIAMPolicyMember(current, testIAMPolicyRole("owner"), user1))
+
+	got = addIAMPolicyMember(current, testIAMPolicyRole("editor"), sp1)
+	require.Equal(t, testIAMPolicy("editor", sp1), got[2])
+
+	got = removeIAMPolicyMember(current, testIAMPolicyRole("viewer"), user1)
+	require.Equal(t, []v1.IamPolicy{
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", user2),
+	}, got)
+
+	// プリンシパルがいなくなったbindingは削除する
+	got = removeIAMPolicyMember(current, testIAMPolicyRole("owner"), user1)
+	require.Equal(t, []v1.IamPolicy{testIAMPolicy("viewer", user1, user2)}, got)
+
+	require.Equal(t, []v1.IamPolicy{
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", user1, user2),
+	}, current)
+}
+
+func TestIAMPolicyMember_duplicatedBindings(t *testing.T) {
+	user1 := testIAMPolicyPrincipal("user", 1)
+	user2 := testIAMPolicyPrincipal("user", 2)
+	user3 := testIAMPolicyPrincipal("user", 3)
+	sp1 := testIAMPolicyPrincipal("service-principal", 1)
+	// 同一ロールのbindingが重複しているポリシー
+	current := []v1.IamPolicy{
+		testIAMPolicy("viewer", user1),
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", user2, user1),
+		testIAMPolicy("viewer", user3),
+	}
+
+	principals, ok := collectIAMPolicyPrincipals(current, testIAMPolicyRole("viewer"))
+	require.True(t, ok)
+	require.Equal(t, []v1.Principal{user1, user2, user3}, principals)
+	require.True(t, hasIAMPolicyMember(current, testIAMPolicyRole("viewer"), user3))
+
+	_, ok = collectIAMPolicyPrincipals(current, testIAMPolicyRole("editor"))
+	require.False(t, ok)
+
+	// 2つ目以降のbindingのプリンシパルも引き継いで1つのbindingにまとめる
+	got := addIAMPolicyMember(current, testIAMPolicyRole("viewer"), sp1)
+	require.Equal(t, []v1.IamPolicy{
+		testIAMPolicy("viewer", user1, user2, user3, sp1),
+		testIAMPolicy("owner", user1),
+	}, got)
+
+	got = removeIAMPolicyMember(current, testIAMPolicyRole("viewer"), user1)
+	require.Equal(t, []v1.IamPolicy{
+		testIAMPolicy("owner", user1),
+		testIAMPolicy("viewer", user2),
+		testIAMPolicy("viewer", user3),
+	}, got)
+}
+
+func TestParseIAMPolicyImportID(t *testing.T) {
+	cases := []struct {
+		id       string
+		n        int
+		target   string
+		targetID string
+		roleType string
+		roleID   string
+		rest     []string
+		errmsg   string
+	}{
+		{id: "organization/preset:owner", n: 0, target: "organization", roleType: "preset", roleID: "owner", rest: []string{}},
+		{id: "project/123456789012/preset:owner", n: 0, target: "project", targetID: "123456789012", roleType: "preset", roleID: "owner", rest: []string{}},
+		{id: "folder/123/custom:viewer/user/1", n: 2, target: "folder", targetID: "123", roleType: "custom", roleID: "viewer", rest: []string{"user", "1"}},
+		{id: "project/123456789012", n: 0, errmsg: "invalid import ID format"},
+		{id: "project", n: 0, errmsg: "target_id is required for project"},
+		{id: "organization/preset:owner/user/", n: 2, errmsg: "invalid import ID format"},
+		{id: "account/preset:owner", n: 0, errmsg: "invalid target 'account'"},
+		{id: "organization/owner", n: 0, errmsg: "invalid role 'owner'"},
+		{id: "organization/preset:", n: 0, errmsg: "invalid role 'preset:'"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.id, func(t *testing.T) {
+			target, targetID, role, rest, err := parseIAMPolicyImportID(tt.id, tt.n)
+			if tt.errmsg != "" {
+				require.ErrorContains(t, err, tt.errmsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.target, target)
+			require.Equal(t, tt.targetID, targetID)
+			require.Equal(t, tt.roleType, role.Type.ValueString())
+			require.Equal(t, tt.roleID, role.ID.ValueString())
+			require.Equal(t, tt.rest, rest)
+		})
+	}
+}
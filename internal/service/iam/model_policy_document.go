@@ -0,0 +1,128 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+)
+
+const (
+	policyDocumentTypeIAM = "iam"
+	policyDocumentTypeID  = "id"
+)
+
+type policyDocumentBindingModel struct {
+	Role       types.String           `tfsdk:"role"`
+	RoleType   types.String           `tfsdk:"role_type"`
+	Principals []policyPrincipalModel `tfsdk:"principals"`
+}
+
+type policyDocumentModel struct {
+	PolicyType types.String                 `tfsdk:"policy_type"`
+	Target     types.String                 `tfsdk:"target"`
+	Binding    []policyDocumentBindingModel `tfsdk:"binding"`
+	Bindings   []policyBindingModel         `tfsdk:"bindings"`
+}
+
+// buildPolicyDocument merges the bindings of the same role and removes the duplicated principals, keeping the order of appearance
+func buildPolicyDocument(inputs []policyDocumentBindingModel) []policyBindingModel {
+	var result []policyBindingModel
+	index := make(map[[2]string]int)
+	for _, in := range inputs {
+		roleType := string(v1.IamPolicyRoleTypePreset)
+		if in.RoleType.ValueString() != "" {
+			roleType = in.RoleType.ValueString()
+		}
+		key := [2]string{roleType, in.Role.ValueString()}
+
+		i, ok := index[key]
+		if !ok {
+			i = len(result)
+			index[key] = i
+			result = append(result, policyBindingModel{
+				Role: &policyRoleModel{
+					Type: types.StringValue(roleType),
+					ID:   types.StringValue(in.Role.ValueString()),
+				},
+				Principals: []policyPrincipalModel{},
+			})
+		}
+		for _, p := range in.Principals {
+			if !slices.ContainsFunc(result[i].Principals, func(v policyPrincipalModel) bool {
+				return v.Type.Equal(p.Type) && v.ID.Equal(p.ID)
+			}) {
+				result[i].Principals = append(result[i].Principals, p)
+			}
+		}
+	}
+	return result
+}
+
+// IAMロールを付与可能な階層は organization > folder > project の順で、lowest_grantable_resource以上の階層でのみ付与できる
+var iamPolicyTargetLevels = map[string]int{
+	targetOrg:     0,
+	targetFolder:  1,
+	targetProject: 2,
+}
+
+func checkIAMRoleGrantable(role *v1.IamRole, target string) error {
+	lowest, ok := iamPolicyTargetLevels[string(role.LowestGrantableResource)]
+	if !ok {
+		return nil
+	}
+	if iamPolicyTargetLevels[target] > lowest {
+		return fmt.Errorf("IAM Role %q can't be granted on %s: the lowest grantable resource is %s", role.ID, target, role.LowestGrantableResource)
+	}
+	return nil
+}
+
+type policyEffectivePermissionsModel struct {
+	Principal     *policyPrincipalModel   `tfsdk:"principal"`
+	Target        types.String            `tfsdk:"target"`
+	TargetID      types.String            `tfsdk:"target_id"`
+	IncludeGroups types.Bool              `tfsdk:"include_groups"`
+	Roles         types.Set               `tfsdk:"roles"`
+	Grants        []policyEffectiveGrant  `tfsdk:"grants"`
+	Hierarchy     []policyHierarchyTarget `tfsdk:"hierarchy"`
+}
+
+type policyEffectiveGrant struct {
+	RoleType      types.String `tfsdk:"role_type"`
+	RoleID        types.String `tfsdk:"role_id"`
+	Target        types.String `tfsdk:"target"`
+	TargetID      types.String `tfsdk:"target_id"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	PrincipalID   types.String `tfsdk:"principal_id"`
+}
+
+type policyHierarchyTarget struct {
+	Target   types.String `tfsdk:"target"`
+	TargetID types.String `tfsdk:"target_id"`
+}
+
+// collectEffectiveGrants returns the grants of the bindings for any of the principals
+func collectEffectiveGrants(level policyHierarchyTarget, bindings []v1.IamPolicy, principals []v1.Principal) []policyEffectiveGrant {
+	var grants []policyEffectiveGrant
+	for _, b := range bindings {
+		for _, p := range b.Principals {
+			if !slices.ContainsFunc(principals, func(v v1.Principal) bool { return isSameIAMPolicyPrincipal(v, p) }) {
+				continue
+			}
+			flattened := flattenIAMPolicyPrincipal(p)
+			grants = append(grants, policyEffectiveGrant{
+				RoleType:      types.StringValue(string(b.Role.Value.Type.Value)),
+				RoleID:        types.StringValue(b.Role.Value.ID.Value),
+				Target:        level.Target,
+				TargetID:      level.TargetID,
+				PrincipalType: flattened.Type,
+				PrincipalID:   flattened.ID,
+			})
+		}
+	}
+	return grants
+}
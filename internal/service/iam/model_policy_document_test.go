@@ -0,0 +1,106 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func testPolicyPrincipalModel(typ, id string) policyPrincipalModel {
+	return policyPrincipalModel{Type: types.StringValue(typ), ID: types.StringValue(id)}
+}
+
+func TestBuildPolicyDocument(t *testing.T) {
+	sp1 := testPolicyPrincipalModel("service-principal", "1")
+	sp2 := testPolicyPrincipalModel("service-principal", "2")
+	user1 := testPolicyPrincipalModel("user", "1")
+
+	got := buildPolicyDocument([]policyDocumentBindingModel{
+		{Role: types.StringValue("viewer"), Principals: []policyPrincipalModel{sp1, user1}},
+		{Role: types.StringValue("owner"), RoleType: types.StringValue("preset"), Principals: []policyPrincipalModel{sp1}},
+		// 同じロールは1つにまとめ、重複したプリンシパルは除く
+		{Role: types.StringValue("viewer"), RoleType: types.StringValue("preset"), Principals: []policyPrincipalModel{sp2, sp1}},
+	})
+
+	require.Equal(t, []policyBindingModel{
+		{
+			Role:       &policyRoleModel{Type: types.StringValue("preset"), ID: types.StringValue("viewer")},
+			Principals: []policyPrincipalModel{sp1, user1, sp2},
+		},
+		{
+			Role:       &policyRoleModel{Type: types.StringValue("preset"), ID: types.StringValue("owner")},
+			Principals: []policyPrincipalModel{sp1},
+		},
+	}, got)
+}
+
+func TestCheckIAMRoleGrantable(t *testing.T) {
+	cases := []struct {
+		lowest    v1.IamRoleLowestGrantableResource
+		grantable []string
+		denied    []string
+	}{
+		{
+			lowest:    v1.IamRoleLowestGrantableResourceOrganization,
+			grantable: []string{targetOrg},
+			denied:    []string{targetFolder, targetProject},
+		},
+		{
+			lowest:    v1.IamRoleLowestGrantableResourceFolder,
+			grantable: []string{targetOrg, targetFolder},
+			denied:    []string{targetProject},
+		},
+		{
+			lowest:    v1.IamRoleLowestGrantableResourceProject,
+			grantable: []string{targetOrg, targetFolder, targetProject},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(string(tt.lowest), func(t *testing.T) {
+			role := &v1.IamRole{ID: "role", LowestGrantableResource: tt.lowest}
+			for _, target := range tt.grantable {
+				require.NoError(t, checkIAMRoleGrantable(role, target), target)
+			}
+			for _, target := range tt.denied {
+				require.ErrorContains(t, checkIAMRoleGrantable(role, target), "can't be granted on "+target)
+			}
+		})
+	}
+}
+
+func TestCollectEffectiveGrants(t *testing.T) {
+	user1 := testIAMPolicyPrincipal("user", 1)
+	group1 := testIAMPolicyPrincipal("group", 10)
+	bindings := []v1.IamPolicy{
+		testIAMPolicy("owner", testIAMPolicyPrincipal("user", 2)),
+		testIAMPolicy("viewer", user1),
+		testIAMPolicy("editor", group1, testIAMPolicyPrincipal("group", 1)),
+	}
+	level := policyHierarchyTarget{Target: types.StringValue(targetFolder), TargetID: types.StringValue("100")}
+
+	got := collectEffectiveGrants(level, bindings, []v1.Principal{user1, group1})
+	require.Equal(t, []policyEffectiveGrant{
+		{
+			RoleType:      types.StringValue("preset"),
+			RoleID:        types.StringValue("viewer"),
+			Target:        types.StringValue(targetFolder),
+			TargetID:      types.StringValue("100"),
+			PrincipalType: types.StringValue("user"),
+			PrincipalID:   types.StringValue("1"),
+		},
+		{
+			RoleType:      types.StringValue("preset"),
+			RoleID:        types.StringValue("editor"),
+			Target:        types.StringValue(targetFolder),
+			TargetID:      types.StringValue("100"),
+			PrincipalType: types.StringValue("group"),
+			PrincipalID:   types.StringValue("10"),
+		},
+	}, got)
+}
@@ -0,0 +1,107 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+)
+
+// IAMのサービスプリンシパルキーはRSA鍵(2048〜4096bit)のみをサポートしている
+const (
+	servicePrincipalKeyMinBits = 2048
+	servicePrincipalKeyMaxBits = 4096
+)
+
+var servicePrincipalKeyBits = []int64{2048, 3072, 4096}
+
+type servicePrincipalKeyBaseModel struct {
+	ID                 types.String `tfsdk:"id"`
+	ServicePrincipalID types.String `tfsdk:"service_principal_id"`
+	PublicKey          types.String `tfsdk:"public_key"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Kid                types.String `tfsdk:"kid"`
+	KeyOrigin          types.String `tfsdk:"key_origin"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	KeyExpiresAt       types.String `tfsdk:"key_expires_at"`
+}
+
+func (model *servicePrincipalKeyBaseModel) updateState(spID int, key *v1.ServicePrincipalKey) {
+	model.ID = types.StringValue(key.ID.String())
+	model.ServicePrincipalID = types.StringValue(strconv.Itoa(spID))
+	// API側で正規化された値による差分を避けるため、設定された公開鍵はそのまま維持する
+	if !utils.IsKnown(model.PublicKey) {
+		model.PublicKey = types.StringValue(string(key.PublicKey))
+	}
+	model.Enabled = types.BoolValue(key.Status == v1.ServicePrincipalKeyStatusEnabled)
+	model.Kid = types.StringValue(key.Kid)
+	model.KeyOrigin = types.StringValue(string(key.KeyOrigin))
+	model.CreatedAt = types.StringValue(key.CreatedAt)
+	if v, ok := key.KeyExpiresAt.Get(); ok {
+		model.KeyExpiresAt = types.StringValue(v)
+	} else {
+		model.KeyExpiresAt = types.StringNull()
+	}
+}
+
+// validateServicePrincipalPublicKey checks that the value is a PEM encoded RSA public key accepted by IAM
+func validateServicePrincipalPublicKey(value string) error {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil {
+		return errors.New("must be a PEM encoded public key")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return fmt.Errorf("unsupported PEM block type %q: PUBLIC KEY or RSA PUBLIC KEY is required", block.Type)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse the public key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("unsupported public key type %T: only RSA keys are supported", key)
+	}
+	if bits := rsaKey.N.BitLen(); bits < servicePrincipalKeyMinBits || bits > servicePrincipalKeyMaxBits {
+		return fmt.Errorf("unsupported RSA key size %d: must be between %d and %d bits", bits, servicePrincipalKeyMinBits, servicePrincipalKeyMaxBits)
+	}
+	return nil
+}
+
+// generateServicePrincipalKeyPair returns a new RSA key pair as PKCS#8 private key and PKIX public key in PEM format
+func generateServicePrincipalKeyPair(bits int) (privateKeyPEM string, publicKeyPEM string, err error) {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate RSA key: %w", err)
+	}
+
+	privDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal the private key: %w", err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal the public key: %w", err)
+	}
+
+	privateKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER}))
+	publicKeyPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}))
+	return privateKeyPEM, publicKeyPEM, nil
+}
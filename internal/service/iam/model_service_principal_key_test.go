@@ -0,0 +1,97 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateServicePrincipalKeyPair(t *testing.T) {
+	privateKey, publicKey, err := generateServicePrincipalKeyPair(2048)
+	require.NoError(t, err)
+	require.NoError(t, validateServicePrincipalPublicKey(publicKey))
+
+	block, _ := pem.Decode([]byte(privateKey))
+	require.NotNil(t, block)
+	require.Equal(t, "PRIVATE KEY", block.Type)
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+
+	// 公開鍵と秘密鍵がペアになっていること
+	pubBlock, _ := pem.Decode([]byte(publicKey))
+	pub, err := x509.ParsePKIXPublicKey(pubBlock.Bytes)
+	require.NoError(t, err)
+	require.True(t, key.(*rsa.PrivateKey).PublicKey.Equal(pub))
+}
+
+func TestValidateServicePrincipalPublicKey(t *testing.T) {
+	rsaPEM := func(bits int, pkcs1 bool) string {
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		require.NoError(t, err)
+		if pkcs1 {
+			return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&key.PublicKey)}))
+		}
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		require.NoError(t, err)
+		return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecDER, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	require.NoError(t, err)
+
+	cases := []struct {
+		scenario string
+		value    string
+		errmsg   string
+	}{
+		{
+			scenario: "PKIX RSA key",
+			value:    rsaPEM(2048, false),
+		},
+		{
+			scenario: "PKCS#1 RSA key",
+			value:    rsaPEM(3072, true),
+		},
+		{
+			scenario: "not PEM",
+			value:    "ssh-rsa AAAA",
+			errmsg:   "must be a PEM encoded public key",
+		},
+		{
+			scenario: "private key",
+			value:    string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: []byte("dummy")})),
+			errmsg:   `unsupported PEM block type "PRIVATE KEY"`,
+		},
+		{
+			scenario: "EC key",
+			value:    string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: ecDER})),
+			errmsg:   "only RSA keys are supported",
+		},
+		{
+			scenario: "too short RSA key",
+			value:    rsaPEM(1024, false),
+			errmsg:   "unsupported RSA key size 1024",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.scenario, func(t *testing.T) {
+			err := validateServicePrincipalPublicKey(tt.value)
+			if tt.errmsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.errmsg)
+			}
+		})
+	}
+}
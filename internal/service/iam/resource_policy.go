@@ -115,7 +115,7 @@ func (r *policyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 				Create: true, Update: true, Delete: true,
 			}),
 		},
-		MarkdownDescription: "Manages an IAM Policy.",
+		MarkdownDescription: "Manages an IAM Policy.\n\nThis resource is authoritative for the whole bindings of the target. Use `sakura_iam_policy_binding` or `sakura_iam_policy_member` to manage a part of the bindings.",
 	}
 }
 
@@ -216,6 +216,46 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func getIAMPolicy(ctx context.Context, client *v1.Client, target, targetId string, diags *diag.Diagnostics) []v1.IamPolicy {
+	res, err := readIAMPolicy(ctx, client, target, targetId)
+	if err != nil {
+		diags.AddError("API Error", err.Error())
+		return nil
+	}
+	return res
+}
+
+func updateIAMPolicy(ctx context.Context, client *v1.Client, model *policyResourceModel) error {
+	target, targetId := model.Target.ValueString(), model.TargetID.ValueString()
+	key := iamPolicyMutexKey(target, targetId)
+	common.SakuraMutexKV.Lock(key)
+	defer common.SakuraMutexKV.Unlock(key)
+
+	return writeIAMPolicy(ctx, client, target, targetId, expandIAMPolicyCreateRequest(model))
+}
+
+// sakura_iam_policy_binding/sakura_iam_policy_memberとの同時更新を避けるため、対象ごとにロックする
+func iamPolicyMutexKey(target, targetId string) string {
+	return fmt.Sprintf("iam_policy/%s/%s", target, targetId)
+}
+
+// modifyIAMPolicy reads the current bindings of the target, applies modify and writes them back under the lock of the target
+func modifyIAMPolicy(ctx context.Context, client *v1.Client, target, targetId string, modify func([]v1.IamPolicy) []v1.IamPolicy) ([]v1.IamPolicy, error) {
+	key := iamPolicyMutexKey(target, targetId)
+	common.SakuraMutexKV.Lock(key)
+	defer common.SakuraMutexKV.Unlock(key)
+
+	bindings, err := readIAMPolicy(ctx, client, target, targetId)
+	if err != nil {
+		return nil, err
+	}
+	bindings = modify(bindings)
+	if err := writeIAMPolicy(ctx, client, target, targetId, bindings); err != nil {
+		return nil, err
+	}
+	return readIAMPolicy(ctx, client, target, targetId)
+}
+
+func readIAMPolicy(ctx context.Context, client *v1.Client, target, targetId string) ([]v1.IamPolicy, error) {
 	var res []v1.IamPolicy
 	var err error
 	op := iam.NewIAMPolicyOp(client)
@@ -223,40 +263,37 @@ func getIAMPolicy(ctx context.Context, client *v1.Client, target, targetId strin
 	case targetProject:
 		res, err = op.ReadProjectPolicy(ctx, utils.MustAtoI(targetId))
 		if err != nil {
-			diags.AddError("API Error", fmt.Sprintf("failed to read IAM Project Policy: %s", err))
-			return nil
+			return nil, fmt.Errorf("failed to read IAM Project Policy: %s", err)
 		}
 	case targetFolder:
 		res, err = op.ReadFolderPolicy(ctx, utils.MustAtoI(targetId))
 		if err != nil {
-			diags.AddError("API Error", fmt.Sprintf("failed to read IAM Folder Policy: %s", err))
-			return nil
+			return nil, fmt.Errorf("failed to read IAM Folder Policy: %s", err)
 		}
 	case targetOrg:
 		res, err = op.ReadOrganizationPolicy(ctx)
 		if err != nil {
-			diags.AddError("API Error", fmt.Sprintf("failed to read IAM Organization Policy: %s", err))
-			return nil
+			return nil, fmt.Errorf("failed to read IAM Organization Policy: %s", err)
 		}
 	}
-	return res
+	return res, nil
 }
 
-func updateIAMPolicy(ctx context.Context, client *v1.Client, model *policyResourceModel) error {
+func writeIAMPolicy(ctx context.Context, client *v1.Client, target, targetId string, bindings []v1.IamPolicy) error {
 	op := iam.NewIAMPolicyOp(client)
-	switch model.Target.ValueString() {
+	switch target {
 	case targetOrg:
-		_, err := op.UpdateOrganizationPolicy(ctx, expandIAMPolicyCreateRequest(model))
+		_, err := op.UpdateOrganizationPolicy(ctx, bindings)
 		if err != nil {
 			return fmt.Errorf("failed to update IAM Organization Policy: %s", err)
 		}
 	case targetFolder:
-		_, err := op.UpdateFolderPolicy(ctx, utils.MustAtoI(model.TargetID.ValueString()), expandIAMPolicyCreateRequest(model))
+		_, err := op.UpdateFolderPolicy(ctx, utils.MustAtoI(targetId), bindings)
 		if err != nil {
 			return fmt.Errorf("failed to update IAM Folder Policy: %s", err)
 		}
 	case targetProject:
-		_, err := op.UpdateProjectPolicy(ctx, utils.MustAtoI(model.TargetID.ValueString()), expandIAMPolicyCreateRequest(model))
+		_, err := op.UpdateProjectPolicy(ctx, utils.MustAtoI(targetId), bindings)
 		if err != nil {
 			return fmt.Errorf("failed to update IAM Project Policy: %s", err)
 		}
@@ -267,19 +304,10 @@ func updateIAMPolicy(ctx context.Context, client *v1.Client, model *policyResour
 func expandIAMPolicyCreateRequest(model *policyResourceModel) []v1.IamPolicy {
 	var policies []v1.IamPolicy
 	for _, b := range model.Bindings {
-		idp := v1.IamPolicy{
-			Role: v1.NewOptIamPolicyRole(v1.IamPolicyRole{
-				Type: v1.NewOptIamPolicyRoleType(v1.IamPolicyRoleType(b.Role.Type.ValueString())),
-				ID:   v1.NewOptString(b.Role.ID.ValueString()),
-			}),
-		}
-		for _, p := range b.Principals {
-			idp.Principals = append(idp.Principals, v1.Principal{
-				Type: v1.NewOptString(p.Type.ValueString()),
-				ID:   v1.NewOptInt(utils.MustAtoI(p.ID.ValueString())),
-			})
-		}
-		policies = append(policies, idp)
+		policies = append(policies, v1.IamPolicy{
+			Role:       v1.NewOptIamPolicyRole(expandIAMPolicyRole(b.Role)),
+			Principals: expandIAMPolicyPrincipals(b.Principals),
+		})
 	}
 	return policies
 }
@@ -0,0 +1,278 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+)
+
+type policyBindingResource struct {
+	client *v1.Client
+}
+
+var (
+	_ resource.Resource                = &policyBindingResource{}
+	_ resource.ResourceWithConfigure   = &policyBindingResource{}
+	_ resource.ResourceWithImportState = &policyBindingResource{}
+)
+
+func NewPolicyBindingResource() resource.Resource {
+	return &policyBindingResource{}
+}
+
+func (r *policyBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_policy_binding"
+}
+
+func (r *policyBindingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.IamClient
+}
+
+type policyBindingResourceModel struct {
+	policyBindingBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func schemaResourcePolicyTarget(name string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"target": schema.StringAttribute{
+			Required:    true,
+			Description: desc.Sprintf("The target of the %s. This must be one of %s.", name, []string{targetProject, targetFolder, targetOrg}),
+			Validators: []validator.String{
+				stringvalidator.OneOf(targetProject, targetFolder, targetOrg),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"target_id": schema.StringAttribute{
+			Optional:    true,
+			Description: "The ID of the target. Required for Folder or Project",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func schemaResourcePolicyRole(name string) schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Required:    true,
+		Description: desc.Sprintf("The role of the %s", name),
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the role",
+			},
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the role",
+			},
+		},
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+var schemaResourcePolicyPrincipalAttributes = map[string]schema.Attribute{
+	"type": schema.StringAttribute{
+		Required:    true,
+		Description: "The type of the principal",
+	},
+	"id": schema.StringAttribute{
+		Required:    true,
+		Description: "The ID of the principal",
+	},
+}
+
+func (r *policyBindingResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := schemaResourcePolicyTarget("IAM Policy Binding")
+	attrs["role"] = schemaResourcePolicyRole("IAM Policy Binding")
+	attrs["principals"] = schema.SetNestedAttribute{
+		Required:    true,
+		Description: "The principals granted the role. The principals which are not listed here are removed from the role",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: schemaResourcePolicyPrincipalAttributes,
+		},
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+	}
+	attrs["timeouts"] = timeouts.Attributes(ctx, timeouts.Opts{
+		Create: true, Update: true, Delete: true,
+	})
+
+	resp.Schema = schema.Schema{
+		Attributes:          attrs,
+		MarkdownDescription: "Manages a binding of a role in an IAM Policy.\n\nThis resource is authoritative for the principals of the role, and the other roles in the IAM Policy are preserved. This can't be used with `sakura_iam_policy` for the same target, and can be used with `sakura_iam_policy_member` only for the different roles.",
+	}
+}
+
+func (r *policyBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	target, targetID, role, _, err := parseIAMPolicyImportID(req.ID, 0)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error",
+			fmt.Sprintf("%s. Please specify the import ID in the format of {target}[/{target_id}]/{role_type}:{role_id}: %s", err, req.ID))
+		return
+	}
+
+	setIAMPolicyImportState(ctx, resp, target, targetID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), role)...)
+}
+
+func (r *policyBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	if err := r.setBinding(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Create: API Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *policyBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bindings := getIAMPolicy(ctx, r.client, state.Target.ValueString(), state.TargetID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principals, _ := collectIAMPolicyPrincipals(bindings, expandIAMPolicyRole(state.Role))
+	if len(principals) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.updateState(principals)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *policyBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan policyBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	if err := r.setBinding(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Update: API Error", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *policyBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyBindingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	role := expandIAMPolicyRole(state.Role)
+	_, err := modifyIAMPolicy(ctx, r.client, state.Target.ValueString(), state.TargetID.ValueString(), func(bindings []v1.IamPolicy) []v1.IamPolicy {
+		return removeIAMPolicyBinding(bindings, role)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete: API Error", err.Error())
+		return
+	}
+}
+
+func (r *policyBindingResource) setBinding(ctx context.Context, model *policyBindingResourceModel) error {
+	role := expandIAMPolicyRole(model.Role)
+	principals := expandIAMPolicyPrincipals(model.Principals)
+	bindings, err := modifyIAMPolicy(ctx, r.client, model.Target.ValueString(), model.TargetID.ValueString(), func(bindings []v1.IamPolicy) []v1.IamPolicy {
+		return setIAMPolicyBinding(bindings, role, principals)
+	})
+	if err != nil {
+		return err
+	}
+
+	principals, ok := collectIAMPolicyPrincipals(bindings, role)
+	if !ok {
+		return fmt.Errorf("the binding of role %q is not found in the IAM Policy after update", role.ID.Value)
+	}
+	model.updateState(principals)
+	return nil
+}
+
+// parseIAMPolicyImportID parses the import ID in the format of {target}[/{target_id}]/{role_type}:{role_id}[/...].
+// n is the number of the parts following the role.
+func parseIAMPolicyImportID(id string, n int) (target, targetID string, role *policyRoleModel, rest []string, err error) {
+	parts := strings.Split(id, "/")
+	switch parts[0] {
+	case targetOrg:
+		rest = parts[1:]
+	case targetFolder, targetProject:
+		if len(parts) < 2 {
+			return "", "", nil, nil, fmt.Errorf("target_id is required for %s", parts[0])
+		}
+		targetID = parts[1]
+		rest = parts[2:]
+	default:
+		return "", "", nil, nil, fmt.Errorf("invalid target '%s'. The target must be one of 'organization', 'folder', or 'project'", parts[0])
+	}
+	if len(rest) != n+1 {
+		return "", "", nil, nil, fmt.Errorf("invalid import ID format")
+	}
+	for _, v := range rest {
+		if v == "" {
+			return "", "", nil, nil, fmt.Errorf("invalid import ID format")
+		}
+	}
+
+	roleType, roleID, ok := strings.Cut(rest[0], ":")
+	if !ok || roleType == "" || roleID == "" {
+		return "", "", nil, nil, fmt.Errorf("invalid role '%s'. The role must be in the format of {role_type}:{role_id}", rest[0])
+	}
+	role = &policyRoleModel{Type: types.StringValue(roleType), ID: types.StringValue(roleID)}
+	return parts[0], targetID, role, rest[1:], nil
+}
+
+func setIAMPolicyImportState(ctx context.Context, resp *resource.ImportStateResponse, target, targetID string) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target"), target)...)
+	if targetID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_id"), targetID)...)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraIAMPolicyBinding_basic(t *testing.T) {
+	test.SkipIfIAMEnvIsNotSet(t)
+
+	resourceName := "sakura_iam_policy_binding.foobar"
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testCheckSakuraIAMProjectDestroy,
+			testCheckSakuraIAMServicePrincipalDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraIAMPolicyBinding_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target", "project"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "sakura_iam_project.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "role.id", "viewer"),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckResourceAttr("sakura_iam_policy_member.foobar", "role.id", "owner"),
+					resource.TestCheckResourceAttrPair("sakura_iam_policy_member.foobar", "principal.id", "sakura_iam_service_principal.foobar", "id"),
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraIAMPolicyBinding_update, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role.id", "viewer"),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "2"),
+					// 同じプロジェクトのメンバーは上書きされない
+					resource.TestCheckResourceAttr("data.sakura_iam_policy.foobar", "bindings.#", "2"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "target_id",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return fmt.Sprintf("project/%s/preset:viewer", rs.Primary.Attributes["target_id"]), nil
+				},
+			},
+			{
+				ResourceName:                         "sakura_iam_policy_member.foobar",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "target_id",
+				ImportStateVerifyIgnore:              []string{"timeouts"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["sakura_iam_policy_member.foobar"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: sakura_iam_policy_member.foobar")
+					}
+					return fmt.Sprintf("project/%s/preset:owner/service-principal/%s", rs.Primary.Attributes["target_id"], rs.Primary.Attributes["principal.id"]), nil
+				},
+			},
+		},
+	})
+}
+
+const testAccSakuraIAMPolicyBinding_base = `
+resource "sakura_iam_project" "foobar" {
+  name = "{{ .arg0 }}"
+  code = "{{ .arg0 }}"
+  description = "description"
+}
+
+resource "sakura_iam_service_principal" "foobar" {
+  name = "{{ .arg0 }}"
+  description = "description"
+  project_id = sakura_iam_project.foobar.id
+}
+
+resource "sakura_iam_service_principal" "foobar2" {
+  name = "{{ .arg0 }}-2"
+  description = "description"
+  project_id = sakura_iam_project.foobar.id
+}
+
+resource "sakura_iam_policy_member" "foobar" {
+  target    = "project"
+  target_id = sakura_iam_project.foobar.id
+  role = {
+    id   = "owner"
+    type = "preset"
+  }
+  principal = {
+    id   = sakura_iam_service_principal.foobar.id
+    type = "service-principal"
+  }
+}
+`
+
+const testAccSakuraIAMPolicyBinding_basic = testAccSakuraIAMPolicyBinding_base + `
+resource "sakura_iam_policy_binding" "foobar" {
+  target    = "project"
+  target_id = sakura_iam_project.foobar.id
+  role = {
+    id   = "viewer"
+    type = "preset"
+  }
+  principals = [{
+    id   = sakura_iam_service_principal.foobar.id
+    type = "service-principal"
+  }]
+}
+`
+
+const testAccSakuraIAMPolicyBinding_update = testAccSakuraIAMPolicyBinding_base + `
+resource "sakura_iam_policy_binding" "foobar" {
+  target    = "project"
+  target_id = sakura_iam_project.foobar.id
+  role = {
+    id   = "viewer"
+    type = "preset"
+  }
+  principals = [
+    {
+      id   = sakura_iam_service_principal.foobar.id
+      type = "service-principal"
+    },
+    {
+      id   = sakura_iam_service_principal.foobar2.id
+      type = "service-principal"
+    },
+  ]
+}
+
+data "sakura_iam_policy" "foobar" {
+  target    = "project"
+  target_id = sakura_iam_project.foobar.id
+
+  depends_on = [sakura_iam_policy_binding.foobar, sakura_iam_policy_member.foobar]
+}
+`
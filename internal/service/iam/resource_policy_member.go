@@ -0,0 +1,165 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+)
+
+type policyMemberResource struct {
+	client *v1.Client
+}
+
+var (
+	_ resource.Resource                = &policyMemberResource{}
+	_ resource.ResourceWithConfigure   = &policyMemberResource{}
+	_ resource.ResourceWithImportState = &policyMemberResource{}
+)
+
+func NewPolicyMemberResource() resource.Resource {
+	return &policyMemberResource{}
+}
+
+func (r *policyMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_policy_member"
+}
+
+func (r *policyMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.IamClient
+}
+
+type policyMemberResourceModel struct {
+	policyMemberBaseModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *policyMemberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := schemaResourcePolicyTarget("IAM Policy Member")
+	attrs["role"] = schemaResourcePolicyRole("IAM Policy Member")
+	attrs["principal"] = schema.SingleNestedAttribute{
+		Required:    true,
+		Description: "The principal granted the role",
+		Attributes:  schemaResourcePolicyPrincipalAttributes,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+	}
+	attrs["timeouts"] = timeouts.Attributes(ctx, timeouts.Opts{
+		Create: true, Delete: true,
+	})
+
+	resp.Schema = schema.Schema{
+		Attributes:          attrs,
+		MarkdownDescription: "Manages a member of a role in an IAM Policy.\n\nThis resource is non-authoritative, and the other principals and roles in the IAM Policy are preserved. This can't be used with `sakura_iam_policy` for the same target, nor with `sakura_iam_policy_binding` for the same role.",
+	}
+}
+
+func (r *policyMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	target, targetID, role, rest, err := parseIAMPolicyImportID(req.ID, 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Import Error",
+			fmt.Sprintf("%s. Please specify the import ID in the format of {target}[/{target_id}]/{role_type}:{role_id}/{principal_type}/{principal_id}: %s", err, req.ID))
+		return
+	}
+
+	setIAMPolicyImportState(ctx, resp, target, targetID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role"), role)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal"), &policyPrincipalModel{
+		Type: types.StringValue(rest[0]),
+		ID:   types.StringValue(rest[1]),
+	})...)
+}
+
+func (r *policyMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	role := expandIAMPolicyRole(plan.Role)
+	principal := expandIAMPolicyPrincipal(plan.Principal)
+	bindings, err := modifyIAMPolicy(ctx, r.client, plan.Target.ValueString(), plan.TargetID.ValueString(), func(bindings []v1.IamPolicy) []v1.IamPolicy {
+		return addIAMPolicyMember(bindings, role, principal)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Create: API Error", err.Error())
+		return
+	}
+	if !hasIAMPolicyMember(bindings, role, principal) {
+		resp.Diagnostics.AddError("Create: API Error",
+			fmt.Sprintf("the principal %s[%d] is not found in the role %q of the IAM Policy after update", principal.Type.Value, principal.ID.Value, role.ID.Value))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *policyMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bindings := getIAMPolicy(ctx, r.client, state.Target.ValueString(), state.TargetID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !hasIAMPolicyMember(bindings, expandIAMPolicyRole(state.Role), expandIAMPolicyPrincipal(state.Principal)) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *policyMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// timeouts以外の属性は全てRequiresReplaceなので、planをそのまま反映する
+	var plan policyMemberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *policyMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	role := expandIAMPolicyRole(state.Role)
+	principal := expandIAMPolicyPrincipal(state.Principal)
+	_, err := modifyIAMPolicy(ctx, r.client, state.Target.ValueString(), state.TargetID.ValueString(), func(bindings []v1.IamPolicy) []v1.IamPolicy {
+		return removeIAMPolicyMember(bindings, role, principal)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Delete: API Error", err.Error())
+		return
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iam-api-go"
+	"github.com/sacloud/iam-api-go/apis/serviceprincipal"
+	v1 "github.com/sacloud/iam-api-go/apis/v1"
+	"github.com/sacloud/saclient-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type servicePrincipalKeyResource struct {
+	client *v1.Client
+}
+
+var (
+	_ resource.Resource                = &servicePrincipalKeyResource{}
+	_ resource.ResourceWithConfigure   = &servicePrincipalKeyResource{}
+	_ resource.ResourceWithImportState = &servicePrincipalKeyResource{}
+)
+
+func NewServicePrincipalKeyResource() resource.Resource {
+	return &servicePrincipalKeyResource{}
+}
+
+func (r *servicePrincipalKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_service_principal_key"
+}
+
+func (r *servicePrincipalKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.IamClient
+}
+
+type servicePrincipalKeyResourceModel struct {
+	servicePrincipalKeyBaseModel
+	PublicKeyWO        types.String   `tfsdk:"public_key_wo"`
+	PublicKeyWOVersion types.Int32    `tfsdk:"public_key_wo_version"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *servicePrincipalKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	publicKeyValidator := sacloudvalidator.StringFuncValidator(validateServicePrincipalPublicKey)
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("IAM Service Principal Key"),
+			"service_principal_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the IAM Service Principal to register the key",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The RSA public key in PEM format. The key size must be between 2048 and 4096 bits. Either `public_key` or `public_key_wo` is required.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("public_key_wo")),
+					publicKeyValidator,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"public_key_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The RSA public key in PEM format. This is useful to register the key generated by `sakura_iam_service_principal_key_pair` ephemeral resource.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("public_key")),
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("public_key_wo_version")),
+					publicKeyValidator,
+				},
+			},
+			"public_key_wo_version": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The version of the public_key_wo field. This value must be greater than 0 when set. Increment this to rotate the key, which registers a new key and deletes the old one.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("public_key_wo")),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the key is enabled. Default is `true`.",
+			},
+			"kid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The key ID (kid) of the key. Use this as `service_principal_key_kid` in the provider configuration.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_origin": schema.StringAttribute{
+				Computed:    true,
+				Description: "The origin of the key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The creation date of the IAM Service Principal Key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiration date of the IAM Service Principal Key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+		MarkdownDescription: "Manages an IAM Service Principal Key.\n\nThe private key is never sent to the API nor stored in the state. Generate the key pair with `sakura_iam_service_principal_key_pair` ephemeral resource and pass the private key to a write-only attribute such as `value_wo` of `sakura_secret_manager_secret`, or register an existing public key. Only RSA keys are supported by IAM.",
+	}
+}
+
+func (r *servicePrincipalKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError("ImportState Error",
+			fmt.Sprintf("Invalid import ID format: %s. Expected format: service_principal_id/key_id", req.ID))
+		return
+	}
+
+	if _, err := uuid.Parse(parts[1]); err != nil {
+		resp.Diagnostics.AddError("ImportState Error", fmt.Sprintf("key_id must be a UUID: %s", parts[1]))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_principal_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+}
+
+func (r *servicePrincipalKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config servicePrincipalKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	publicKey := plan.PublicKey.ValueString()
+	if utils.IsKnown(config.PublicKeyWO) {
+		publicKey = config.PublicKeyWO.ValueString()
+	}
+
+	spID := utils.MustAtoI(plan.ServicePrincipalID.ValueString())
+	spOp := iam.NewServicePrincipalOp(r.client)
+	key, err := spOp.UploadKey(ctx, spID, v1.ServiceprincipalKeyPublicKey(publicKey))
+	if err != nil {
+		resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to upload IAM Service Principal Key[%d]: %s", spID, err))
+		return
+	}
+
+	if !plan.Enabled.ValueBool() {
+		keyID := key.ID
+		key, err = spOp.DisableKey(ctx, spID, keyID)
+		if err != nil {
+			resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to disable IAM Service Principal Key[%s]: %s", keyID, err))
+			return
+		}
+	}
+
+	plan.updateState(spID, key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *servicePrincipalKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state servicePrincipalKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := getServicePrincipalKey(ctx, r.client, state.ServicePrincipalID.ValueString(), state.ID.ValueString(), &resp.State, &resp.Diagnostics)
+	if key == nil {
+		return
+	}
+
+	state.updateState(utils.MustAtoI(state.ServicePrincipalID.ValueString()), key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *servicePrincipalKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan servicePrincipalKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	spID := utils.MustAtoI(plan.ServicePrincipalID.ValueString())
+	keyID := uuid.MustParse(plan.ID.ValueString())
+	spOp := iam.NewServicePrincipalOp(r.client)
+
+	var key *v1.ServicePrincipalKey
+	var err error
+	if plan.Enabled.ValueBool() {
+		key, err = spOp.EnableKey(ctx, spID, keyID)
+	} else {
+		key, err = spOp.DisableKey(ctx, spID, keyID)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("failed to update status of IAM Service Principal Key[%s]: %s", keyID, err))
+		return
+	}
+
+	plan.updateState(spID, key)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *servicePrincipalKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state servicePrincipalKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	key := getServicePrincipalKey(ctx, r.client, state.ServicePrincipalID.ValueString(), state.ID.ValueString(), &resp.State, &resp.Diagnostics)
+	if key == nil {
+		return
+	}
+
+	spOp := iam.NewServicePrincipalOp(r.client)
+	if err := spOp.DeleteKey(ctx, utils.MustAtoI(state.ServicePrincipalID.ValueString()), key.ID); err != nil {
+		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to delete IAM Service Principal Key[%s]: %s", key.ID, err))
+		return
+	}
+}
+
+func getServicePrincipalKey(ctx context.Context, client *v1.Client, spID, keyID string, state *tfsdk.State, diags *diag.Diagnostics) *v1.ServicePrincipalKey {
+	spOp := iam.NewServicePrincipalOp(client)
+	perPage := 100
+	for page := 1; ; page++ {
+		keys, err := spOp.ListKeys(ctx, utils.MustAtoI(spID), serviceprincipal.ListKeysParams{Page: &page, PerPage: &perPage})
+		if err != nil {
+			if saclient.IsNotFoundError(err) {
+				state.RemoveResource(ctx)
+				return nil
+			}
+			diags.AddError("API Read Error", fmt.Sprintf("failed to list IAM Service Principal Keys[%s]: %s", spID, err.Error()))
+			return nil
+		}
+		for i := range keys.Items {
+			if keys.Items[i].ID.String() == keyID {
+				return &keys.Items[i]
+			}
+		}
+		if len(keys.Items) == 0 || page*perPage >= keys.Count {
+			break
+		}
+	}
+
+	state.RemoveResource(ctx)
+	return nil
+}
@@ -31,6 +31,7 @@ type ipv4PtrResource struct {
 	_ resource.Resource                = &ipv4PtrResource{}
 	_ resource.ResourceWithConfigure   = &ipv4PtrResource{}
 	_ resource.ResourceWithImportState = &ipv4PtrResource{}
+	_ resource.ResourceWithModifyPlan  = &ipv4PtrResource{}
 )
 
 func NewIPv4PtrResource() resource.Resource {
@@ -49,6 +50,10 @@ func (r *ipv4PtrResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = apiclient
 }
 
+func (r *ipv4PtrResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type ipv4PtrResourceModel struct {
 	ID            types.String   `tfsdk:"id"`
 	IPAddress     types.String   `tfsdk:"ip_address"`
@@ -0,0 +1,151 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sacloud/kms-api-go"
+	v1 "github.com/sacloud/kms-api-go/apis/v1"
+)
+
+const (
+	// データキーはAES-256で、ローカルでのペイロードの暗号化にはAES-256-GCMを使う
+	kmsDataKeySize    = 32
+	kmsEnvelopePrefix = "sakura-kms-envelope:v1:"
+)
+
+// kmsEnvelope is the envelope encrypted payload. Only the data key is encrypted with the KMS key,
+// and the payload is encrypted locally with the data key.
+type kmsEnvelope struct {
+	EncryptedKey string `json:"encrypted_key"`
+	Nonce        []byte `json:"nonce"`
+	Ciphertext   []byte `json:"ciphertext"`
+}
+
+func generateKMSDataKey() ([]byte, error) {
+	key := make([]byte, kmsDataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	return key, nil
+}
+
+func newKMSDataKeyAEAD(dataKey []byte) (cipher.AEAD, error) {
+	if len(dataKey) != kmsDataKeySize {
+		return nil, fmt.Errorf("invalid data key size: %d bytes, expected %d bytes", len(dataKey), kmsDataKeySize)
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func sealKMSEnvelope(dataKey []byte, encryptedKey string, plain []byte) (string, error) {
+	aead, err := newKMSDataKeyAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	data, err := json.Marshal(&kmsEnvelope{
+		EncryptedKey: encryptedKey,
+		Nonce:        nonce,
+		Ciphertext:   aead.Seal(nil, nonce, plain, nil),
+	})
+	if err != nil {
+		return "", err
+	}
+	return kmsEnvelopePrefix + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// parseKMSEnvelope returns nil without error when the ciphertext is not envelope encrypted
+func parseKMSEnvelope(ciphertext string) (*kmsEnvelope, error) {
+	encoded, ok := strings.CutPrefix(ciphertext, kmsEnvelopePrefix)
+	if !ok {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid envelope: %w", err)
+	}
+	var envelope kmsEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid envelope: %w", err)
+	}
+	if envelope.EncryptedKey == "" {
+		return nil, errors.New("invalid envelope: encrypted_key is empty")
+	}
+	return &envelope, nil
+}
+
+func (e *kmsEnvelope) open(dataKey []byte) ([]byte, error) {
+	aead, err := newKMSDataKeyAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(e.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid envelope: nonce size is %d bytes, expected %d bytes", len(e.Nonce), aead.NonceSize())
+	}
+	plain, err := aead.Open(nil, e.Nonce, e.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt envelope: %w", err)
+	}
+	return plain, nil
+}
+
+func encryptKMS(ctx context.Context, client *v1.Client, keyID string, plain []byte, algo v1.KeyEncryptAlgoEnum, envelope bool) (string, error) {
+	keyOp := kms.NewKeyOp(client)
+	if !envelope {
+		ciphertext, err := keyOp.Encrypt(ctx, keyID, plain, algo)
+		if err != nil {
+			return "", fmt.Errorf("failed to encrypt with KMS key[%s]: %s", keyID, err)
+		}
+		return ciphertext, nil
+	}
+
+	dataKey, err := generateKMSDataKey()
+	if err != nil {
+		return "", err
+	}
+	encryptedKey, err := keyOp.Encrypt(ctx, keyID, dataKey, algo)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt data key with KMS key[%s]: %s", keyID, err)
+	}
+	return sealKMSEnvelope(dataKey, encryptedKey, plain)
+}
+
+func decryptKMS(ctx context.Context, client *v1.Client, keyID string, ciphertext string) ([]byte, error) {
+	envelope, err := parseKMSEnvelope(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	keyOp := kms.NewKeyOp(client)
+	if envelope == nil {
+		plain, err := keyOp.Decrypt(ctx, keyID, ciphertext)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt with KMS key[%s]: %s", keyID, err)
+		}
+		return plain, nil
+	}
+
+	dataKey, err := keyOp.Decrypt(ctx, keyID, envelope.EncryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key with KMS key[%s]: %s", keyID, err)
+	}
+	return envelope.open(dataKey)
+}
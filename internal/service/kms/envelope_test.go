@@ -0,0 +1,51 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kms
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKMSEnvelope(t *testing.T) {
+	dataKey, err := generateKMSDataKey()
+	require.NoError(t, err)
+	require.Len(t, dataKey, kmsDataKeySize)
+
+	sealed, err := sealKMSEnvelope(dataKey, "encrypted-key", []byte("plaintext"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(sealed, kmsEnvelopePrefix))
+	require.NotContains(t, sealed, "plaintext")
+
+	envelope, err := parseKMSEnvelope(sealed)
+	require.NoError(t, err)
+	require.NotNil(t, envelope)
+	require.Equal(t, "encrypted-key", envelope.EncryptedKey)
+
+	plain, err := envelope.open(dataKey)
+	require.NoError(t, err)
+	require.Equal(t, "plaintext", string(plain))
+
+	otherKey, err := generateKMSDataKey()
+	require.NoError(t, err)
+	_, err = envelope.open(otherKey)
+	require.Error(t, err)
+
+	_, err = envelope.open(dataKey[:16])
+	require.ErrorContains(t, err, "invalid data key size")
+}
+
+func TestParseKMSEnvelope(t *testing.T) {
+	envelope, err := parseKMSEnvelope("not-envelope-ciphertext")
+	require.NoError(t, err)
+	require.Nil(t, envelope)
+
+	_, err = parseKMSEnvelope(kmsEnvelopePrefix + "!!!")
+	require.ErrorContains(t, err, "invalid envelope")
+
+	_, err = parseKMSEnvelope(kmsEnvelopePrefix + "e30=") // {}
+	require.ErrorContains(t, err, "encrypted_key is empty")
+}
@@ -0,0 +1,125 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/kms-api-go"
+	v1 "github.com/sacloud/kms-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+)
+
+type kmsDataKeyEphemeralResource struct {
+	client *v1.Client
+}
+
+var (
+	_ ephemeral.EphemeralResource              = &kmsDataKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &kmsDataKeyEphemeralResource{}
+)
+
+func NewKMSDataKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &kmsDataKeyEphemeralResource{}
+}
+
+func (r *kmsDataKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_data_key"
+}
+
+func (r *kmsDataKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.KmsClient
+}
+
+type kmsDataKeyEphemeralModel struct {
+	KMSID      types.String `tfsdk:"kms_id"`
+	Algorithm  types.String `tfsdk:"algorithm"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	Plaintext  types.String `tfsdk:"plaintext"`
+}
+
+func (r *kmsDataKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kms_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the KMS key to encrypt the data key",
+			},
+			"algorithm": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The algorithm to encrypt the generated data key. This must be one of [%s]. Default is `%s`.", kmsEncryptAlgorithms, string(v1.KeyEncryptAlgoEnumAes256Gcm)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(kmsEncryptAlgorithms...),
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("ciphertext")),
+				},
+			},
+			"ciphertext": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The data key encrypted with the KMS key. If specified, the data key is decrypted instead of generating a new one. Store this value to decrypt the data key later.",
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: desc.Sprintf("The base64 encoded %d bytes data key for AES-256.", kmsDataKeySize),
+			},
+		},
+		MarkdownDescription: "Generates or decrypts a data key for the envelope encryption with a KMS key.\n\nA new data key is generated locally every time the ephemeral resource is opened unless `ciphertext` is specified. Only the data key is sent to the API, so the payloads encrypted with the data key never leave the host in plaintext.",
+	}
+}
+
+func (r *kmsDataKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data kmsDataKeyEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyID := data.KMSID.ValueString()
+	keyOp := kms.NewKeyOp(r.client)
+	if data.Ciphertext.ValueString() != "" {
+		dataKey, err := keyOp.Decrypt(ctx, keyID, data.Ciphertext.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Open: API Error", fmt.Sprintf("failed to decrypt data key with KMS key[%s]: %s", keyID, err))
+			return
+		}
+		data.Plaintext = types.StringValue(base64.StdEncoding.EncodeToString(dataKey))
+		resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+		return
+	}
+
+	algo := string(v1.KeyEncryptAlgoEnumAes256Gcm)
+	if data.Algorithm.ValueString() != "" {
+		algo = data.Algorithm.ValueString()
+	}
+	dataKey, err := generateKMSDataKey()
+	if err != nil {
+		resp.Diagnostics.AddError("Open: Key Generation Error", err.Error())
+		return
+	}
+	encryptedKey, err := keyOp.Encrypt(ctx, keyID, dataKey, v1.KeyEncryptAlgoEnum(algo))
+	if err != nil {
+		resp.Diagnostics.AddError("Open: API Error", fmt.Sprintf("failed to encrypt data key with KMS key[%s]: %s", keyID, err))
+		return
+	}
+
+	data.Algorithm = types.StringValue(algo)
+	data.Ciphertext = types.StringValue(encryptedKey)
+	data.Plaintext = types.StringValue(base64.StdEncoding.EncodeToString(dataKey))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,83 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/kms-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+)
+
+type kmsDecryptEphemeralResource struct {
+	client *v1.Client
+}
+
+var (
+	_ ephemeral.EphemeralResource              = &kmsDecryptEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &kmsDecryptEphemeralResource{}
+)
+
+func NewKMSDecryptEphemeralResource() ephemeral.EphemeralResource {
+	return &kmsDecryptEphemeralResource{}
+}
+
+func (r *kmsDecryptEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_decrypt"
+}
+
+func (r *kmsDecryptEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.KmsClient
+}
+
+type kmsDecryptEphemeralModel struct {
+	KMSID      types.String `tfsdk:"kms_id"`
+	Ciphertext types.String `tfsdk:"ciphertext"`
+	Plaintext  types.String `tfsdk:"plaintext"`
+}
+
+func (r *kmsDecryptEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kms_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the KMS key used to encrypt the ciphertext",
+			},
+			"ciphertext": schema.StringAttribute{
+				Required:    true,
+				Description: "The ciphertext to decrypt. Both the ciphertext encrypted directly with the KMS key and the envelope encrypted one by `sakura_kms_ciphertext` are supported.",
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The decrypted plaintext",
+			},
+		},
+		MarkdownDescription: "Decrypts a ciphertext encrypted with a KMS key at apply time, without storing the plaintext in the state.",
+	}
+}
+
+func (r *kmsDecryptEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data kmsDecryptEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plain, err := decryptKMS(ctx, r.client, data.KMSID.ValueString(), data.Ciphertext.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Open: API Error", err.Error())
+		return
+	}
+
+	data.Plaintext = types.StringValue(string(plain))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -134,7 +134,7 @@ func (r *kmsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp
 				Create: true, Update: true, Delete: true,
 			}),
 		},
-		MarkdownDescription: "Manages a KMS.",
+		MarkdownDescription: "Manages a KMS.\n\nThe key material of an imported key is sent to the API as `plain_key` over TLS only once at creation. The KMS API doesn't provide a wrapping public key or an import token, so wrapping the key material before upload (RSA-OAEP/AES-KWP) and re-importing it on rotation are not supported. Use `plain_key_wo` to keep the key material out of the state.",
 	}
 }
 
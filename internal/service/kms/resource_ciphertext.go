@@ -0,0 +1,201 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/kms-api-go/apis/v1"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+)
+
+type kmsCiphertextResource struct {
+	client *v1.Client
+}
+
+var (
+	_ resource.Resource              = &kmsCiphertextResource{}
+	_ resource.ResourceWithConfigure = &kmsCiphertextResource{}
+)
+
+func NewKMSCiphertextResource() resource.Resource {
+	return &kmsCiphertextResource{}
+}
+
+func (r *kmsCiphertextResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kms_ciphertext"
+}
+
+func (r *kmsCiphertextResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.KmsClient
+}
+
+type kmsCiphertextResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	KMSID              types.String   `tfsdk:"kms_id"`
+	Plaintext          types.String   `tfsdk:"plaintext"`
+	PlaintextWO        types.String   `tfsdk:"plaintext_wo"`
+	PlaintextWOVersion types.Int32    `tfsdk:"plaintext_wo_version"`
+	Algorithm          types.String   `tfsdk:"algorithm"`
+	Envelope           types.Bool     `tfsdk:"envelope"`
+	Ciphertext         types.String   `tfsdk:"ciphertext"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+var kmsEncryptAlgorithms = common.MapTo(v1.KeyEncryptAlgoEnumAes256Gcm.AllValues(), common.ToString)
+
+func (r *kmsCiphertextResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the KMS ciphertext. This is generated by the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kms_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the KMS key to encrypt the plaintext",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The plaintext to encrypt. Either `plaintext` or `plaintext_wo` is required.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("plaintext_wo")),
+					stringvalidator.PreferWriteOnlyAttribute(path.MatchRoot("plaintext_wo")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plaintext_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The plaintext to encrypt. The value is not stored in the state.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("plaintext")),
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("plaintext_wo_version")),
+				},
+			},
+			"plaintext_wo_version": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The version of the plaintext_wo field. This value must be greater than 0 when set. Increment this to encrypt the updated plaintext_wo.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("plaintext_wo")),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(v1.KeyEncryptAlgoEnumAes256Gcm)),
+				Description: desc.Sprintf("The encryption algorithm. This must be one of [%s]. Default is `%s`.", kmsEncryptAlgorithms, string(v1.KeyEncryptAlgoEnumAes256Gcm)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(kmsEncryptAlgorithms...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"envelope": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to use the envelope encryption. If true, a data key is generated locally and only the data key is encrypted with the KMS key, so the plaintext is never sent to the API. `algorithm` is used to encrypt the data key. Default is `false`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"ciphertext": schema.StringAttribute{
+				Computed:    true,
+				Description: "The encrypted ciphertext. This can be decrypted with `sakura_kms_decrypt` ephemeral resource.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+		MarkdownDescription: "Encrypts a plaintext with a KMS key and stores the ciphertext in the state.\n\nThe ciphertext is kept stable until the plaintext or the other arguments are changed. Rotating the KMS key doesn't re-encrypt the ciphertext.",
+	}
+}
+
+func (r *kmsCiphertextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan, config kmsCiphertextResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	plaintext := plan.Plaintext.ValueString()
+	if !config.PlaintextWO.IsNull() {
+		plaintext = config.PlaintextWO.ValueString()
+	}
+
+	ciphertext, err := encryptKMS(ctx, r.client, plan.KMSID.ValueString(), []byte(plaintext),
+		v1.KeyEncryptAlgoEnum(plan.Algorithm.ValueString()), plan.Envelope.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Create: API Error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	plan.Ciphertext = types.StringValue(ciphertext)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read/Update/Deleteでは何もしない。暗号文はKMSキーが存在する限りステートの値のまま有効
+func (r *kmsCiphertextResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data kmsCiphertextResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *kmsCiphertextResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan kmsCiphertextResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *kmsCiphertextResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,133 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kms_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraResourceKMSCiphertext_basic(t *testing.T) {
+	resourceName := "sakura_kms_ciphertext.foobar"
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { test.AccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"sakura": providerserver.NewProtocol6WithError(test.AccProvider),
+			"echo":   echoprovider.NewProviderServer(),
+		},
+		CheckDestroy: testCheckSakuraKMSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraKMSCiphertext_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ciphertext"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "aes-256-gcm"),
+					resource.TestCheckResourceAttr(resourceName, "envelope", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "plaintext_wo"),
+					resource.TestMatchResourceAttr("sakura_kms_ciphertext.envelope", "ciphertext", regexp.MustCompile(`^sakura-kms-envelope:v1:`)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("plain"), knownvalue.StringExact("secret-value")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("envelope"), knownvalue.StringExact("large-secret-value")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSakuraEphemeralKMSDataKey_basic(t *testing.T) {
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { test.AccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"sakura": providerserver.NewProtocol6WithError(test.AccProvider),
+			"echo":   echoprovider.NewProviderServer(),
+		},
+		CheckDestroy: testCheckSakuraKMSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEphemeralKMSDataKey_basic, rand),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("algorithm"), knownvalue.StringExact("aes-256-gcm")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("ciphertext"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("plaintext"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`))),
+				},
+			},
+		},
+	})
+}
+
+const testAccSakuraKMSCiphertext_basic = `
+resource "sakura_kms" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_kms_ciphertext" "foobar" {
+  kms_id               = sakura_kms.foobar.id
+  plaintext_wo         = "secret-value"
+  plaintext_wo_version = 1
+}
+
+resource "sakura_kms_ciphertext" "envelope" {
+  kms_id    = sakura_kms.foobar.id
+  plaintext = "large-secret-value"
+  envelope  = true
+}
+
+ephemeral "sakura_kms_decrypt" "plain" {
+  kms_id     = sakura_kms.foobar.id
+  ciphertext = sakura_kms_ciphertext.foobar.ciphertext
+}
+
+ephemeral "sakura_kms_decrypt" "envelope" {
+  kms_id     = sakura_kms.foobar.id
+  ciphertext = sakura_kms_ciphertext.envelope.ciphertext
+}
+
+provider "echo" {
+  data = {
+    plain    = ephemeral.sakura_kms_decrypt.plain.plaintext
+    envelope = ephemeral.sakura_kms_decrypt.envelope.plaintext
+  }
+}
+
+resource "echo" "test" {}
+`
+
+const testAccSakuraEphemeralKMSDataKey_basic = `
+resource "sakura_kms" "foobar" {
+  name = "{{ .arg0 }}"
+}
+
+ephemeral "sakura_kms_data_key" "foobar" {
+  kms_id = sakura_kms.foobar.id
+}
+
+provider "echo" {
+  data = ephemeral.sakura_kms_data_key.foobar
+}
+
+resource "echo" "test" {}
+`
@@ -0,0 +1,318 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitoring_suite
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goccy/go-yaml"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	v1 "github.com/sacloud/monitoring-suite-api-go/apis/v1"
+)
+
+const (
+	alertRuleSeverityWarning  = "warning"
+	alertRuleSeverityCritical = "critical"
+	// 閾値の継続時間を指定しない場合、APIは120秒として扱う
+	alertRuleDefaultThresholdDuration = 120
+	alertRuleMaxTemplateLength        = 256
+)
+
+var alertRuleSeverities = []string{alertRuleSeverityWarning, alertRuleSeverityCritical}
+
+// Prometheusのルールファイルのうち、アラートルールへの変換に必要な項目のみを扱う
+type prometheusRuleFile struct {
+	Groups []prometheusRuleGroup `yaml:"groups"`
+}
+
+type prometheusRuleGroup struct {
+	Name  string           `yaml:"name"`
+	Rules []prometheusRule `yaml:"rules"`
+}
+
+type prometheusRule struct {
+	Alert       string            `yaml:"alert"`
+	Record      string            `yaml:"record"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for"`
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+}
+
+type alertRuleGroupRuleModel struct {
+	ID                        types.String `tfsdk:"id"`
+	Query                     types.String `tfsdk:"query"`
+	Template                  types.String `tfsdk:"template"`
+	EnabledWarning            types.Bool   `tfsdk:"enabled_warning"`
+	EnabledCritical           types.Bool   `tfsdk:"enabled_critical"`
+	ThresholdWarning          types.String `tfsdk:"threshold_warning"`
+	ThresholdCritical         types.String `tfsdk:"threshold_critical"`
+	ThresholdDurationWarning  types.Int64  `tfsdk:"threshold_duration_warning"`
+	ThresholdDurationCritical types.Int64  `tfsdk:"threshold_duration_critical"`
+}
+
+func (m alertRuleGroupRuleModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                          types.StringType,
+		"query":                       types.StringType,
+		"template":                    types.StringType,
+		"enabled_warning":             types.BoolType,
+		"enabled_critical":            types.BoolType,
+		"threshold_warning":           types.StringType,
+		"threshold_critical":          types.StringType,
+		"threshold_duration_warning":  types.Int64Type,
+		"threshold_duration_critical": types.Int64Type,
+	}
+}
+
+// equalSpec はID以外の項目が一致するかを返す
+func (m alertRuleGroupRuleModel) equalSpec(other alertRuleGroupRuleModel) bool {
+	return m.Query.Equal(other.Query) &&
+		m.Template.Equal(other.Template) &&
+		m.EnabledWarning.Equal(other.EnabledWarning) &&
+		m.EnabledCritical.Equal(other.EnabledCritical) &&
+		m.ThresholdWarning.Equal(other.ThresholdWarning) &&
+		m.ThresholdCritical.Equal(other.ThresholdCritical) &&
+		m.ThresholdDurationWarning.Equal(other.ThresholdDurationWarning) &&
+		m.ThresholdDurationCritical.Equal(other.ThresholdDurationCritical)
+}
+
+// flattenAlertRuleGroupRule はAPIのアラートルールを変換する。
+// 無効なレベルの閾値はYAMLから削除されてもAPI上に残るため、expandAlertRuleGroupRulesと同じく未設定として扱う。
+func flattenAlertRuleGroupRule(alertRule *v1.AlertRule) alertRuleGroupRuleModel {
+	var base alertRuleBaseModel
+	base.updateState(alertRule)
+	if !base.EnabledWarning.ValueBool() {
+		base.ThresholdWarning = types.StringNull()
+		base.ThresholdDurationWarning = types.Int64Value(alertRuleDefaultThresholdDuration)
+	}
+	if !base.EnabledCritical.ValueBool() {
+		base.ThresholdCritical = types.StringNull()
+		base.ThresholdDurationCritical = types.Int64Value(alertRuleDefaultThresholdDuration)
+	}
+	return alertRuleGroupRuleModel{
+		ID:                        base.ID,
+		Query:                     base.Query,
+		Template:                  base.Template,
+		EnabledWarning:            base.EnabledWarning,
+		EnabledCritical:           base.EnabledCritical,
+		ThresholdWarning:          base.ThresholdWarning,
+		ThresholdCritical:         base.ThresholdCritical,
+		ThresholdDurationWarning:  base.ThresholdDurationWarning,
+		ThresholdDurationCritical: base.ThresholdDurationCritical,
+	}
+}
+
+// expandAlertRuleGroupRules はPrometheusのルールファイルをアラート名をキーとしたアラートルールに変換する。
+// 同じアラート名でseverityの異なるルールは、1つのアラートルールのwarning/criticalレベルとしてまとめる。
+// 返すルールのIDはUnknownとなる。
+func expandAlertRuleGroupRules(rulesYAML string) (map[string]alertRuleGroupRuleModel, error) {
+	var file prometheusRuleFile
+	if err := yaml.Unmarshal([]byte(rulesYAML), &file); err != nil {
+		return nil, errors.New(yaml.FormatError(err, false, true))
+	}
+
+	rules := make(map[string]alertRuleGroupRuleModel)
+	for _, group := range file.Groups {
+		for i, rule := range group.Rules {
+			where := fmt.Sprintf("groups[%s].rules[%d]", group.Name, i)
+			if rule.Record != "" {
+				return nil, fmt.Errorf("%s: recording rules are not supported", where)
+			}
+			if rule.Alert == "" {
+				return nil, fmt.Errorf("%s: alert is required", where)
+			}
+			if len(rule.Alert) > 64 {
+				return nil, fmt.Errorf("%s: alert name must be at most 64 characters", where)
+			}
+
+			query, threshold, err := splitPrometheusAlertExpr(rule.Expr)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", where, err)
+			}
+			duration := int64(alertRuleDefaultThresholdDuration)
+			if rule.For != "" {
+				d, err := parsePrometheusDuration(rule.For)
+				if err != nil {
+					return nil, fmt.Errorf("%s: invalid for: %w", where, err)
+				}
+				duration = d
+			}
+			severity := rule.Labels["severity"]
+			if severity == "" {
+				severity = alertRuleSeverityCritical
+			}
+
+			current, ok := rules[rule.Alert]
+			if !ok {
+				current = alertRuleGroupRuleModel{
+					ID:                        types.StringUnknown(),
+					Query:                     types.StringValue(query),
+					Template:                  types.StringNull(),
+					EnabledWarning:            types.BoolValue(false),
+					EnabledCritical:           types.BoolValue(false),
+					ThresholdWarning:          types.StringNull(),
+					ThresholdCritical:         types.StringNull(),
+					ThresholdDurationWarning:  types.Int64Value(alertRuleDefaultThresholdDuration),
+					ThresholdDurationCritical: types.Int64Value(alertRuleDefaultThresholdDuration),
+				}
+			} else if current.Query.ValueString() != query {
+				return nil, fmt.Errorf("%s: alert %q is defined with different queries: %q and %q", where, rule.Alert, current.Query.ValueString(), query)
+			}
+
+			switch severity {
+			case alertRuleSeverityWarning:
+				if current.EnabledWarning.ValueBool() {
+					return nil, fmt.Errorf("%s: alert %q has multiple %s rules", where, rule.Alert, severity)
+				}
+				current.EnabledWarning = types.BoolValue(true)
+				current.ThresholdWarning = types.StringValue(threshold)
+				current.ThresholdDurationWarning = types.Int64Value(duration)
+			case alertRuleSeverityCritical:
+				if current.EnabledCritical.ValueBool() {
+					return nil, fmt.Errorf("%s: alert %q has multiple %s rules", where, rule.Alert, severity)
+				}
+				current.EnabledCritical = types.BoolValue(true)
+				current.ThresholdCritical = types.StringValue(threshold)
+				current.ThresholdDurationCritical = types.Int64Value(duration)
+			default:
+				return nil, fmt.Errorf("%s: severity label must be one of %v, got %q", where, alertRuleSeverities, severity)
+			}
+
+			if current.Template.IsNull() {
+				template := rule.Annotations["summary"]
+				if template == "" {
+					template = rule.Annotations["description"]
+				}
+				if len(template) > alertRuleMaxTemplateLength {
+					return nil, fmt.Errorf("%s: annotation for template must be at most %d characters", where, alertRuleMaxTemplateLength)
+				}
+				if template != "" {
+					current.Template = types.StringValue(template)
+				}
+			}
+			rules[rule.Alert] = current
+		}
+	}
+	return rules, nil
+}
+
+var prometheusComparisonOperators = []string{"==", "!=", ">=", "<=", ">", "<"}
+
+// 括弧の外側にあると、最後の比較演算子より前の部分がクエリとして扱えなくなる論理演算子と二項演算の修飾子
+var prometheusUnsupportedKeywords = []string{"and", "or", "unless", "bool", "on", "ignoring", "group_left", "group_right"}
+
+// splitPrometheusAlertExpr は`rate(foo[5m]) > 10`のような式を、クエリと閾値(`> 10`)に分割する。
+// 閾値として扱うのは、括弧や文字列の外側にある唯一の比較演算子と、その右辺の数値のみ。
+// 括弧の外側に論理演算子や修飾子、複数の比較演算子がある式は分割できないためエラーとする。
+func splitPrometheusAlertExpr(expr string) (string, string, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return "", "", errors.New("expr is required")
+	}
+
+	depth := 0
+	var quote rune
+	pos, op := -1, ""
+	for i := 0; i < len(expr); i++ {
+		c := rune(expr[i])
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch c {
+		case '"', '\'', '`':
+			quote = c
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		default:
+			if depth != 0 {
+				continue
+			}
+			if isPrometheusIdentChar(c) {
+				j := i
+				for j < len(expr) && isPrometheusIdentChar(rune(expr[j])) {
+					j++
+				}
+				if word := expr[i:j]; slices.Contains(prometheusUnsupportedKeywords, strings.ToLower(word)) {
+					return "", "", fmt.Errorf("expr must be a single comparison against a number. `%s` outside of parentheses is not supported, wrap the query in parentheses: %q", word, expr)
+				}
+				i = j - 1
+				continue
+			}
+			for _, o := range prometheusComparisonOperators {
+				if strings.HasPrefix(expr[i:], o) {
+					if pos >= 0 {
+						return "", "", fmt.Errorf("expr must be a single comparison against a number. Multiple comparison operators outside of parentheses are not supported: %q", expr)
+					}
+					pos, op = i, o
+					i += len(o) - 1
+					break
+				}
+			}
+		}
+	}
+	if pos < 0 {
+		return "", "", fmt.Errorf("expr must end with a comparison against a number (e.g. `foo > 10`): %q", expr)
+	}
+
+	query := strings.TrimSpace(expr[:pos])
+	value := strings.TrimSpace(expr[pos+len(op):])
+	if _, err := strconv.ParseFloat(value, 64); err != nil || query == "" {
+		return "", "", fmt.Errorf("expr must end with a comparison against a number (e.g. `foo > 10`): %q", expr)
+	}
+	return query, op + " " + value, nil
+}
+
+func isPrometheusIdentChar(c rune) bool {
+	return c == '_' || c == ':' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+var prometheusDurationRegexp = regexp.MustCompile(`^(?:(\d+)y)?(?:(\d+)w)?(?:(\d+)d)?(?:(\d+)h)?(?:(\d+)m)?(?:(\d+)s)?(?:(\d+)ms)?$`)
+
+// parsePrometheusDuration はPrometheusの期間表記(e.g. `1h30m`)を秒数に変換する
+func parsePrometheusDuration(s string) (int64, error) {
+	matches := prometheusDurationRegexp.FindStringSubmatch(s)
+	if s == "" || matches == nil {
+		return 0, fmt.Errorf("not a valid duration string: %q", s)
+	}
+
+	units := []time.Duration{
+		365 * 24 * time.Hour,
+		7 * 24 * time.Hour,
+		24 * time.Hour,
+		time.Hour,
+		time.Minute,
+		time.Second,
+		time.Millisecond,
+	}
+	var d time.Duration
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(matches[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("not a valid duration string: %q", s)
+		}
+		d += time.Duration(n) * unit
+	}
+	if d%time.Second != 0 {
+		return 0, fmt.Errorf("duration must be a multiple of seconds: %q", s)
+	}
+	return int64(d / time.Second), nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitoring_suite
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandAlertRuleGroupRules(t *testing.T) {
+	rules, err := expandAlertRuleGroupRules(`
+groups:
+  - name: node
+    rules:
+      - alert: HighLoad
+        expr: avg by (instance) (node_load1{job="node"}) > 2
+        for: 5m
+        labels:
+          severity: warning
+        annotations:
+          summary: "High load on {{ $labels.instance }}"
+      - alert: HighLoad
+        expr: avg by (instance) (node_load1{job="node"}) > 4
+        for: 1h30m
+        labels:
+          severity: critical
+        annotations:
+          summary: "Very high load"
+      - alert: InstanceDown
+        expr: up == 0
+        annotations:
+          description: "Instance is down"
+`)
+	require.NoError(t, err)
+	require.Len(t, rules, 2)
+
+	highLoad := rules["HighLoad"]
+	require.True(t, highLoad.ID.IsUnknown())
+	require.Equal(t, `avg by (instance) (node_load1{job="node"})`, highLoad.Query.ValueString())
+	require.Equal(t, "High load on {{ $labels.instance }}", highLoad.Template.ValueString())
+	require.True(t, highLoad.EnabledWarning.ValueBool())
+	require.True(t, highLoad.EnabledCritical.ValueBool())
+	require.Equal(t, "> 2", highLoad.ThresholdWarning.ValueString())
+	require.Equal(t, "> 4", highLoad.ThresholdCritical.ValueString())
+	require.Equal(t, int64(300), highLoad.ThresholdDurationWarning.ValueInt64())
+	require.Equal(t, int64(5400), highLoad.ThresholdDurationCritical.ValueInt64())
+
+	instanceDown := rules["InstanceDown"]
+	require.Equal(t, "up", instanceDown.Query.ValueString())
+	require.Equal(t, "Instance is down", instanceDown.Template.ValueString())
+	require.False(t, instanceDown.EnabledWarning.ValueBool())
+	require.True(t, instanceDown.EnabledCritical.ValueBool())
+	require.True(t, instanceDown.ThresholdWarning.IsNull())
+	require.Equal(t, "== 0", instanceDown.ThresholdCritical.ValueString())
+	require.Equal(t, int64(alertRuleDefaultThresholdDuration), instanceDown.ThresholdDurationWarning.ValueInt64())
+	require.Equal(t, int64(alertRuleDefaultThresholdDuration), instanceDown.ThresholdDurationCritical.ValueInt64())
+
+	updated := highLoad
+	updated.ID = types.StringValue("id")
+	require.True(t, updated.equalSpec(highLoad))
+	updated.ThresholdCritical = types.StringValue("> 5")
+	require.False(t, updated.equalSpec(highLoad))
+}
+
+func TestExpandAlertRuleGroupRules_Error(t *testing.T) {
+	cases := []struct {
+		name string
+		yaml string
+		err  string
+	}{
+		{
+			name: "invalid yaml",
+			yaml: "groups: [",
+		},
+		{
+			name: "recording rule",
+			yaml: "groups:\n- name: g\n  rules:\n  - record: foo\n    expr: sum(bar)\n",
+			err:  "recording rules are not supported",
+		},
+		{
+			name: "no threshold",
+			yaml: "groups:\n- name: g\n  rules:\n  - alert: foo\n    expr: absent(up)\n",
+			err:  "expr must end with a comparison against a number",
+		},
+		{
+			name: "unknown severity",
+			yaml: "groups:\n- name: g\n  rules:\n  - alert: foo\n    expr: up == 0\n    labels:\n      severity: info\n",
+			err:  "severity label must be one of",
+		},
+		{
+			name: "duplicated severity",
+			yaml: "groups:\n- name: g\n  rules:\n  - alert: foo\n    expr: up == 0\n  - alert: foo\n    expr: up == 0\n",
+			err:  "has multiple critical rules",
+		},
+		{
+			name: "different queries",
+			yaml: "groups:\n- name: g\n  rules:\n  - alert: foo\n    expr: up == 0\n    labels:\n      severity: warning\n  - alert: foo\n    expr: down == 0\n",
+			err:  "is defined with different queries",
+		},
+		{
+			name: "invalid for",
+			yaml: "groups:\n- name: g\n  rules:\n  - alert: foo\n    expr: up == 0\n    for: 5 minutes\n",
+			err:  "invalid for",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := expandAlertRuleGroupRules(tt.yaml)
+			require.Error(t, err)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestSplitPrometheusAlertExpr(t *testing.T) {
+	cases := []struct {
+		expr      string
+		query     string
+		threshold string
+	}{
+		{expr: "up == 0", query: "up", threshold: "== 0"},
+		{expr: `rate(http_requests_total{code!="200"}[5m]) >= 0.5`, query: `rate(http_requests_total{code!="200"}[5m])`, threshold: ">= 0.5"},
+		{expr: `(a > 1) < 10`, query: `(a > 1)`, threshold: "< 10"},
+		{expr: `label_replace(up, "x", ">", "", "") != 1`, query: `label_replace(up, "x", ">", "", "")`, threshold: "!= 1"},
+		{expr: `(a > 1 and b < 2) == 1`, query: `(a > 1 and b < 2)`, threshold: "== 1"},
+		{expr: `sum by (job) (node_load1) > 2`, query: `sum by (job) (node_load1)`, threshold: "> 2"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.expr, func(t *testing.T) {
+			query, threshold, err := splitPrometheusAlertExpr(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.query, query)
+			require.Equal(t, tt.threshold, threshold)
+		})
+	}
+
+	for _, expr := range []string{"", "up", "up > down", "> 1"} {
+		_, _, err := splitPrometheusAlertExpr(expr)
+		require.Error(t, err, expr)
+	}
+	// 括弧の外側の論理演算子や修飾子は、最後の比較演算子だけを閾値とすると誤ったクエリになるため拒否する
+	for expr, word := range map[string]string{
+		"a > 1 and b < 2":            "and",
+		"a > 1 or b < 2":             "or",
+		"a unless b < 2":             "unless",
+		"a > bool 1":                 "bool",
+		"a / on(job) b > 1":          "on",
+		"a / ignoring(code) b > 0.5": "ignoring",
+	} {
+		_, _, err := splitPrometheusAlertExpr(expr)
+		require.ErrorContains(t, err, "`"+word+"` outside of parentheses is not supported", expr)
+	}
+	_, _, err := splitPrometheusAlertExpr("a > 1 < 2")
+	require.ErrorContains(t, err, "Multiple comparison operators")
+}
+
+func TestParsePrometheusDuration(t *testing.T) {
+	for s, want := range map[string]int64{"30s": 30, "5m": 300, "1h30m": 5400, "1d": 86400, "1w": 604800, "1m1000ms": 61} {
+		got, err := parsePrometheusDuration(s)
+		require.NoError(t, err)
+		require.Equal(t, want, got, s)
+	}
+
+	for _, s := range []string{"", "5", "m5", "5 m", "1500ms"} {
+		_, err := parsePrometheusDuration(s)
+		require.Error(t, err, s)
+	}
+}
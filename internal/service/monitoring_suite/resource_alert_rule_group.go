@@ -0,0 +1,403 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitoring_suite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	monitoringsuite "github.com/sacloud/monitoring-suite-api-go"
+	monitoringsuiteapi "github.com/sacloud/monitoring-suite-api-go/apis/v1"
+	"github.com/sacloud/saclient-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+)
+
+type alertRuleGroupResource struct {
+	client *monitoringsuiteapi.Client
+}
+
+var (
+	_ resource.Resource               = &alertRuleGroupResource{}
+	_ resource.ResourceWithConfigure  = &alertRuleGroupResource{}
+	_ resource.ResourceWithModifyPlan = &alertRuleGroupResource{}
+)
+
+func NewAlertRuleGroupResource() resource.Resource {
+	return &alertRuleGroupResource{}
+}
+
+func (r *alertRuleGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitoring_suite_alert_rule_group"
+}
+
+func (r *alertRuleGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.MonitoringSuiteClient
+}
+
+type alertRuleGroupResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	AlertProjectID  types.String   `tfsdk:"alert_project_id"`
+	MetricStorageID types.String   `tfsdk:"metric_storage_id"`
+	RulesYAML       types.String   `tfsdk:"rules_yaml"`
+	Rules           types.Map      `tfsdk:"rules"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *alertRuleGroupResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the Monitoring Suite Alert Rule Group. This is generated by the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alert_project_id": schemaResourceAlertProjectId(),
+			"metric_storage_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The resource ID of the Metric Storage queried by the Alert Rules.",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+			},
+			"rules_yaml": schema.StringAttribute{
+				Required: true,
+				Description: desc.Sprintf("The Prometheus rule file in YAML. Each alerting rule under `groups[].rules[]` is reconciled as an Alert Rule named after `alert`. "+
+					"The trailing comparison of `expr` is used as the threshold, so `expr` must have a single comparison against a number outside of parentheses and no `and`, `or`, `unless`, `bool`, `on` or `ignoring` outside of parentheses. `for` is used as the threshold duration and `annotations.summary` (or `annotations.description`) as the template. "+
+					"The `severity` label must be one of [%s] and defaults to `%s`. Rules sharing the same `alert` and query with different severities are merged into a single Alert Rule.",
+					alertRuleSeverities, alertRuleSeverityCritical),
+				Validators: []validator.String{
+					sacloudvalidator.StringFuncValidator(func(v string) error {
+						_, err := expandAlertRuleGroupRules(v)
+						return err
+					}),
+				},
+			},
+			"rules": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The Alert Rules managed by this resource, keyed by the alert name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the Alert Rule.",
+						},
+						"query": schema.StringAttribute{
+							Computed:    true,
+							Description: "The query of the Alert Rule.",
+						},
+						"template": schema.StringAttribute{
+							Computed:    true,
+							Description: "The template of the Alert Rule.",
+						},
+						"enabled_warning": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether warning level of the Alert Rule is enabled.",
+						},
+						"enabled_critical": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether critical level of the Alert Rule is enabled.",
+						},
+						"threshold_warning": schema.StringAttribute{
+							Computed:    true,
+							Description: "The threshold of warning level of the Alert Rule.",
+						},
+						"threshold_critical": schema.StringAttribute{
+							Computed:    true,
+							Description: "The threshold of critical level of the Alert Rule.",
+						},
+						"threshold_duration_warning": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The threshold duration (in seconds) of warning level of the Alert Rule.",
+						},
+						"threshold_duration_critical": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The threshold duration (in seconds) of critical level of the Alert Rule.",
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+		MarkdownDescription: "Manages a group of Monitoring Suite Alert Rules defined by a Prometheus rule file.\n\n" +
+			"Each alert is reconciled individually by its name: added alerts are created, changed alerts are updated and removed alerts are deleted. " +
+			"Do not manage the same Alert Rules with `sakura_monitoring_suite_alert_rule`.",
+	}
+}
+
+// ModifyPlan はrules_yamlから期待されるrulesを計算し、既存のアラートルールのIDを引き継ぐ。
+// これによりYAMLの変更だけでなく、コンソール等で行われたアラートルールの変更も差分として検出される。
+func (r *alertRuleGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state alertRuleGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() || !utils.IsKnown(plan.RulesYAML) {
+		return
+	}
+
+	desired, err := expandAlertRuleGroupRules(plan.RulesYAML.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("rules_yaml"), "Invalid Prometheus Rule File", err.Error())
+		return
+	}
+	current := getAlertRuleGroupRules(ctx, state.Rules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for name, rule := range desired {
+		if cur, ok := current[name]; ok {
+			rule.ID = cur.ID
+			desired[name] = rule
+		}
+	}
+
+	rules, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: alertRuleGroupRuleModel{}.AttributeTypes()}, desired)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rules"), rules)...)
+}
+
+func (r *alertRuleGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan alertRuleGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout20min)
+	defer cancel()
+
+	desired, err := expandAlertRuleGroupRules(plan.RulesYAML.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Create: Invalid Prometheus Rule File", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	rules, err := r.reconcile(ctx, plan.AlertProjectID.ValueString(), plan.MetricStorageID.ValueString(), false, nil, desired)
+	// エラー時はリソースがtaintされ次回のapplyで再作成されるが、その際に作成済みのアラートルールが削除されるようstateに保存しておく
+	r.setState(ctx, &plan, rules, &resp.State, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to create Alert Rule Group: %s", err))
+	}
+}
+
+func (r *alertRuleGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state alertRuleGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := getAlertRuleGroupRules(ctx, state.Rules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op := monitoringsuite.NewAlertRuleOp(r.client)
+	rules := make(map[string]alertRuleGroupRuleModel, len(current))
+	for name, rule := range current {
+		ruleID, err := parseUUID(rule.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Read: Invalid State", fmt.Sprintf("invalid ID of Alert Rule[%s]: %s", name, err))
+			return
+		}
+		alertRule, err := op.Read(ctx, state.AlertProjectID.ValueString(), ruleID)
+		if err != nil {
+			// 削除されたアラートルールはstateから取り除き、次回のapplyで再作成する
+			if saclient.IsNotFoundError(err) {
+				continue
+			}
+			resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read Alert Rule[%s]: %s", rule.ID.ValueString(), err))
+			return
+		}
+		rules[name] = flattenAlertRuleGroupRule(alertRule)
+	}
+
+	r.setState(ctx, &state, rules, &resp.State, &resp.Diagnostics)
+}
+
+func (r *alertRuleGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state alertRuleGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout20min)
+	defer cancel()
+
+	desired, err := expandAlertRuleGroupRules(plan.RulesYAML.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Update: Invalid Prometheus Rule File", err.Error())
+		return
+	}
+	current := getAlertRuleGroupRules(ctx, state.Rules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	metricStorageChanged := !plan.MetricStorageID.Equal(state.MetricStorageID)
+	rules, err := r.reconcile(ctx, plan.AlertProjectID.ValueString(), plan.MetricStorageID.ValueString(), metricStorageChanged, current, desired)
+	r.setState(ctx, &plan, rules, &resp.State, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("failed to update Alert Rule Group[%s]: %s", plan.ID.ValueString(), err))
+	}
+}
+
+func (r *alertRuleGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state alertRuleGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout20min)
+	defer cancel()
+
+	current := getAlertRuleGroupRules(ctx, state.Rules, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := r.reconcile(ctx, state.AlertProjectID.ValueString(), state.MetricStorageID.ValueString(), false, current, nil)
+	if err != nil {
+		r.setState(ctx, &state, rules, &resp.State, &resp.Diagnostics)
+		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to delete Alert Rule Group[%s]: %s", state.ID.ValueString(), err))
+	}
+}
+
+// reconcile はアラート名をキーに現在のアラートルールと期待するアラートルールを比較し、個別に作成・更新・削除する。
+// エラーが発生した場合でも、その時点で存在するアラートルールを返す。
+func (r *alertRuleGroupResource) reconcile(ctx context.Context, projectID, metricStorageID string, metricStorageChanged bool,
+	current, desired map[string]alertRuleGroupRuleModel) (map[string]alertRuleGroupRuleModel, error) {
+	op := monitoringsuite.NewAlertRuleOp(r.client)
+	result := make(map[string]alertRuleGroupRuleModel, len(current))
+	maps.Copy(result, current)
+
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		id := current[name].ID.ValueString()
+		ruleID, err := parseUUID(id)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid ID of Alert Rule[%s]: %w", name, err))
+			continue
+		}
+		if err := op.Delete(ctx, projectID, ruleID); err != nil && !saclient.IsNotFoundError(err) {
+			errs = append(errs, fmt.Errorf("failed to delete Alert Rule[%s(%s)]: %w", name, id, err))
+			continue
+		}
+		delete(result, name)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(desired)) {
+		rule := desired[name]
+		cur, exists := current[name]
+		if exists && !metricStorageChanged && cur.equalSpec(rule) {
+			continue
+		}
+
+		if !exists {
+			created, err := op.Create(ctx, projectID, monitoringsuite.AlertRuleCreateParams{
+				MetricsStorageID:          metricStorageID,
+				Name:                      &name,
+				Query:                     rule.Query.ValueString(),
+				Template:                  expandOptionalString(rule.Template),
+				EnabledWarning:            expandOptionalBool(rule.EnabledWarning),
+				EnabledCritical:           expandOptionalBool(rule.EnabledCritical),
+				ThresholdWarning:          expandOptionalString(rule.ThresholdWarning),
+				ThresholdCritical:         expandOptionalString(rule.ThresholdCritical),
+				ThresholdDurationWarning:  expandOptionalInt64(rule.ThresholdDurationWarning),
+				ThresholdDurationCritical: expandOptionalInt64(rule.ThresholdDurationCritical),
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to create Alert Rule[%s]: %w", name, err))
+				continue
+			}
+			result[name] = flattenAlertRuleGroupRule(created)
+			continue
+		}
+
+		ruleID, err := parseUUID(cur.ID.ValueString())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("invalid ID of Alert Rule[%s]: %w", name, err))
+			continue
+		}
+		// 空文字列を送ることで、YAMLから削除されたtemplateをクリアする
+		updated, err := op.Update(ctx, projectID, ruleID, monitoringsuite.AlertRuleUpdateParams{
+			MetricsStorageID:          &metricStorageID,
+			Name:                      &name,
+			Query:                     expandOptionalString(rule.Query),
+			Template:                  common.Ptr(rule.Template.ValueString()),
+			EnabledWarning:            expandOptionalBool(rule.EnabledWarning),
+			EnabledCritical:           expandOptionalBool(rule.EnabledCritical),
+			ThresholdWarning:          expandOptionalString(rule.ThresholdWarning),
+			ThresholdCritical:         expandOptionalString(rule.ThresholdCritical),
+			ThresholdDurationWarning:  expandOptionalInt64(rule.ThresholdDurationWarning),
+			ThresholdDurationCritical: expandOptionalInt64(rule.ThresholdDurationCritical),
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to update Alert Rule[%s(%s)]: %w", name, cur.ID.ValueString(), err))
+			continue
+		}
+		result[name] = flattenAlertRuleGroupRule(updated)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+func (r *alertRuleGroupResource) setState(ctx context.Context, model *alertRuleGroupResourceModel, rules map[string]alertRuleGroupRuleModel, state *tfsdk.State, diags *diag.Diagnostics) {
+	value, d := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: alertRuleGroupRuleModel{}.AttributeTypes()}, rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	model.Rules = value
+	diags.Append(state.Set(ctx, model)...)
+}
+
+func getAlertRuleGroupRules(ctx context.Context, value types.Map, diags *diag.Diagnostics) map[string]alertRuleGroupRuleModel {
+	rules := make(map[string]alertRuleGroupRuleModel)
+	if !utils.IsKnown(value) {
+		return rules
+	}
+	diags.Append(value.ElementsAs(ctx, &rules, false)...)
+	return rules
+}
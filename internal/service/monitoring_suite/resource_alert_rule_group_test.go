@@ -0,0 +1,161 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package monitoring_suite_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	monitoringsuite "github.com/sacloud/monitoring-suite-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraMonitoringSuiteAlertRuleGroup_basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, "SAKURA_MONITORING_SUITE_METRIC_STORAGE_ID")
+
+	resourceName := "sakura_monitoring_suite_alert_rule_group.foobar"
+	rand := test.RandomName()
+	sId := os.Getenv("SAKURA_MONITORING_SUITE_METRIC_STORAGE_ID")
+
+	var highLoadID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraMonitoringSuiteAlertRuleGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraMonitoringSuiteAlertRuleGroup_basic, rand, sId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "alert_project_id", "sakura_monitoring_suite_alert_project.foobar", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.HighLoad.id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.query", "avg(node_load1)"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.template", "High load"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.enabled_warning", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.enabled_critical", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.threshold_warning", "> 2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.threshold_critical", "> 4"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.threshold_duration_warning", "300"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.threshold_duration_critical", "600"),
+					resource.TestCheckResourceAttr(resourceName, "rules.InstanceDown.query", "up"),
+					resource.TestCheckResourceAttr(resourceName, "rules.InstanceDown.enabled_warning", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.InstanceDown.threshold_critical", "== 0"),
+					func(s *terraform.State) error {
+						highLoadID = s.RootModule().Resources[resourceName].Primary.Attributes["rules.HighLoad.id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraMonitoringSuiteAlertRuleGroup_update, rand, sId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.%", "2"),
+					// 同じ名前のアラートルールは再作成されずに更新される
+					func(s *terraform.State) error {
+						id := s.RootModule().Resources[resourceName].Primary.Attributes["rules.HighLoad.id"]
+						if id != highLoadID {
+							return fmt.Errorf("alert rule HighLoad is recreated: %s -> %s", highLoadID, id)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.enabled_warning", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.threshold_critical", "> 8"),
+					resource.TestCheckResourceAttr(resourceName, "rules.HighLoad.threshold_duration_critical", "3600"),
+					resource.TestCheckNoResourceAttr(resourceName, "rules.InstanceDown.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.DiskFull.id"),
+					resource.TestCheckResourceAttr(resourceName, "rules.DiskFull.query", "max(disk_used_percent)"),
+					resource.TestCheckResourceAttr(resourceName, "rules.DiskFull.threshold_warning", ">= 90"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSakuraMonitoringSuiteAlertRuleGroupDestroy(s *terraform.State) error {
+	client := test.AccClientGetter()
+	op := monitoringsuite.NewAlertRuleOp(client.MonitoringSuiteClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sakura_monitoring_suite_alert_rule_group" {
+			continue
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "rules.") || !strings.HasSuffix(k, ".id") {
+				continue
+			}
+			_, err := op.Read(context.Background(), rs.Primary.Attributes["alert_project_id"], uuid.MustParse(v))
+			if err == nil {
+				return fmt.Errorf("still exists monitoring suite alert rule: %s", v)
+			}
+		}
+	}
+	return nil
+}
+
+var testAccSakuraMonitoringSuiteAlertRuleGroup_basic = `
+resource "sakura_monitoring_suite_alert_project" "foobar" {
+  name = "{{ .arg0 }}"
+  description = "description"
+}
+
+resource "sakura_monitoring_suite_alert_rule_group" "foobar" {
+  alert_project_id = sakura_monitoring_suite_alert_project.foobar.id
+  metric_storage_id = {{ .arg1 }}
+  rules_yaml = <<-EOT
+    groups:
+      - name: node
+        rules:
+          - alert: HighLoad
+            expr: avg(node_load1) > 2
+            for: 5m
+            labels:
+              severity: warning
+            annotations:
+              summary: High load
+          - alert: HighLoad
+            expr: avg(node_load1) > 4
+            for: 10m
+            labels:
+              severity: critical
+          - alert: InstanceDown
+            expr: up == 0
+  EOT
+}
+`
+
+var testAccSakuraMonitoringSuiteAlertRuleGroup_update = `
+resource "sakura_monitoring_suite_alert_project" "foobar" {
+  name = "{{ .arg0 }}"
+  description = "description"
+}
+
+resource "sakura_monitoring_suite_alert_rule_group" "foobar" {
+  alert_project_id = sakura_monitoring_suite_alert_project.foobar.id
+  metric_storage_id = {{ .arg1 }}
+  rules_yaml = <<-EOT
+    groups:
+      - name: node
+        rules:
+          - alert: HighLoad
+            expr: avg(node_load1) > 8
+            for: 1h
+            labels:
+              severity: critical
+            annotations:
+              summary: High load
+          - alert: DiskFull
+            expr: max(disk_used_percent) >= 90
+            labels:
+              severity: warning
+  EOT
+}
+`
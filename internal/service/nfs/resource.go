@@ -36,6 +36,7 @@ type nfsResource struct {
 	_ resource.Resource                = &nfsResource{}
 	_ resource.ResourceWithConfigure   = &nfsResource{}
 	_ resource.ResourceWithImportState = &nfsResource{}
+	_ resource.ResourceWithModifyPlan  = &nfsResource{}
 )
 
 func NewNFSResource() resource.Resource {
@@ -54,6 +55,10 @@ func (r *nfsResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = apiclient
 }
 
+func (r *nfsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type nfsResourceModel struct {
 	nfsBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
@@ -8,17 +8,23 @@
 	"fmt"
 	"io"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/minio/minio-go/v7"
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
 )
 
-type objectStorageObjectDataSource struct{}
+type objectStorageObjectDataSource struct {
+	s3Defaults *common.ObjectStorageConfig
+}
 
 var (
-	_ datasource.DataSource = &objectStorageObjectDataSource{}
+	_ datasource.DataSource              = &objectStorageObjectDataSource{}
+	_ datasource.DataSourceWithConfigure = &objectStorageObjectDataSource{}
 )
 
 func NewObjectStorageObjectDataSource() datasource.DataSource {
@@ -29,6 +35,14 @@ func (d *objectStorageObjectDataSource) Metadata(_ context.Context, req datasour
 	resp.TypeName = req.ProviderTypeName + "_object_storage_object"
 }
 
+func (d *objectStorageObjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.s3Defaults = &apiclient.ObjectStorageConfig
+}
+
 type objectStorageObjectDataSourceModel struct {
 	objectStorageObjectBaseModel
 	Body           types.String `tfsdk:"body"`
@@ -59,14 +73,20 @@ func (d *objectStorageObjectDataSource) Schema(_ context.Context, _ datasource.S
 				Description: "The endpoint of the Object Storage Site",
 			},
 			"access_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "The access key for the Object Storage",
+				Description: "The access key for the Object Storage. If omitted, `object_storage.access_key` in the provider configuration is used.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_key")),
+				},
 			},
 			"secret_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "The secret key for the Object Storage",
+				Description: "The secret key for the Object Storage. If omitted, `object_storage.secret_key` in the provider configuration is used.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("access_key")),
+				},
 			},
 			"bucket": schema.StringAttribute{
 				Required:    true,
@@ -156,7 +176,7 @@ func (d *objectStorageObjectDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	minioClient, err := data.getMinIOClient()
+	minioClient, err := data.getMinIOClient(d.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Read: Client Error", fmt.Sprintf("failed to create MinIO client: %s", err.Error()))
 		return
@@ -192,7 +212,7 @@ func (d *objectStorageObjectDataSource) Read(ctx context.Context, req datasource
 		return
 	}
 
-	data.updateState(&info)
+	data.updateState(&info, d.s3Defaults)
 	data.Body = types.StringValue(string(content))
 	data.Size = types.Int64Value(info.Size)
 	data.ACL = types.StringValue(aclInfo.Metadata["X-Amz-Acl"][0])
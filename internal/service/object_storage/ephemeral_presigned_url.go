@@ -0,0 +1,225 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package object_storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+)
+
+type objectStoragePresignedURLEphemeralResource struct {
+	s3Defaults *common.ObjectStorageConfig
+}
+
+var (
+	_ ephemeral.EphemeralResource                   = &objectStoragePresignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &objectStoragePresignedURLEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &objectStoragePresignedURLEphemeralResource{}
+)
+
+func NewObjectStoragePresignedURLEphemeralResource() ephemeral.EphemeralResource {
+	return &objectStoragePresignedURLEphemeralResource{}
+}
+
+func (r *objectStoragePresignedURLEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_presigned_url"
+}
+
+func (r *objectStoragePresignedURLEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.s3Defaults = &apiclient.ObjectStorageConfig
+}
+
+type objectStoragePresignedURLEphemeralModel struct {
+	Region                     types.String `tfsdk:"region"`
+	Endpoint                   types.String `tfsdk:"endpoint"`
+	AccessKey                  types.String `tfsdk:"access_key"`
+	SecretKey                  types.String `tfsdk:"secret_key"`
+	Bucket                     types.String `tfsdk:"bucket"`
+	Key                        types.String `tfsdk:"key"`
+	Method                     types.String `tfsdk:"method"`
+	ExpirationSeconds          types.Int64  `tfsdk:"expiration_seconds"`
+	ResponseCacheControl       types.String `tfsdk:"response_cache_control"`
+	ResponseContentDisposition types.String `tfsdk:"response_content_disposition"`
+	ResponseContentEncoding    types.String `tfsdk:"response_content_encoding"`
+	ResponseContentLanguage    types.String `tfsdk:"response_content_language"`
+	ResponseContentType        types.String `tfsdk:"response_content_type"`
+	ResponseExpires            types.String `tfsdk:"response_expires"`
+	URL                        types.String `tfsdk:"url"`
+	ExpiresAt                  types.String `tfsdk:"expires_at"`
+}
+
+func (r *objectStoragePresignedURLEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	responseHeader := func(header string) schema.Attribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Description: desc.Sprintf("The value of the `%s` header returned when the presigned URL is accessed. Only available with `GET` method.", header),
+		}
+	}
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The region of the Object Storage Site. Default is `jp-north-1`.",
+			},
+			"endpoint": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The endpoint of the Object Storage Site. Default is `s3.isk01.sakurastorage.jp`.",
+			},
+			"access_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The access key for the Object Storage. If omitted, `object_storage.access_key` in the provider configuration is used.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_key")),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The secret key for the Object Storage. If omitted, `object_storage.secret_key` in the provider configuration is used.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("access_key")),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Object Storage Bucket",
+			},
+			"key": schema.StringAttribute{
+				Required:    true,
+				Description: "The key of the Object Storage Object",
+			},
+			"method": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The HTTP method allowed with the presigned URL. This must be one of [%s]. Default is `GET`.", presignMethods),
+				Validators: []validator.String{
+					stringvalidator.OneOf(presignMethods...),
+				},
+			},
+			"expiration_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The number of seconds the presigned URL is valid for. %s. Default is `%d`.", desc.Range(1, presignMaxExpiration), presignDefaultExpiration),
+				Validators: []validator.Int64{
+					int64validator.Between(1, presignMaxExpiration),
+				},
+			},
+			"response_cache_control":       responseHeader("Cache-Control"),
+			"response_content_disposition": responseHeader("Content-Disposition"),
+			"response_content_encoding":    responseHeader("Content-Encoding"),
+			"response_content_language":    responseHeader("Content-Language"),
+			"response_content_type":        responseHeader("Content-Type"),
+			"response_expires":             responseHeader("Expires"),
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiration time of the presigned URL in RFC3339 format.",
+			},
+		},
+		MarkdownDescription: "Generates a presigned URL of an Object Storage's Object.\n\nThis ephemeral resource needs object_storage_permission's access_key/secret_key for the S3-compatible API, which can be specified in the resource or `object_storage` block of the provider configuration. The URL is signed locally and no API request is sent.",
+	}
+}
+
+func (r *objectStoragePresignedURLEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data objectStoragePresignedURLEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !utils.IsKnown(data.Method) || strings.ToUpper(data.Method.ValueString()) == http.MethodGet {
+		return
+	}
+	for name := range data.responseHeaders() {
+		resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Attribute Combination",
+			fmt.Sprintf("%s is supported only with GET method", name))
+	}
+}
+
+func (r *objectStoragePresignedURLEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data objectStoragePresignedURLEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.expandPresignParams(r.s3Defaults)
+	params.SigningTime = time.Now()
+	u, err := presignObjectURL(params)
+	if err != nil {
+		resp.Diagnostics.AddError("Open: Presign Error", err.Error())
+		return
+	}
+
+	data.Region = types.StringValue(getRegion(params.Region))
+	data.Endpoint = types.StringValue(getEndpoint(params.Endpoint))
+	data.Method = types.StringValue(params.Method)
+	data.ExpirationSeconds = types.Int64Value(int64(params.Expiration / time.Second))
+	data.URL = types.StringValue(u.String())
+	data.ExpiresAt = types.StringValue(params.expiresAt(params.SigningTime))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (model *objectStoragePresignedURLEphemeralModel) responseHeaders() map[string]string {
+	headers := map[string]types.String{
+		"response_cache_control":       model.ResponseCacheControl,
+		"response_content_disposition": model.ResponseContentDisposition,
+		"response_content_encoding":    model.ResponseContentEncoding,
+		"response_content_language":    model.ResponseContentLanguage,
+		"response_content_type":        model.ResponseContentType,
+		"response_expires":             model.ResponseExpires,
+	}
+	result := make(map[string]string)
+	for k, v := range headers {
+		if v.ValueString() != "" {
+			result[k] = v.ValueString()
+		}
+	}
+	return result
+}
+
+func (model *objectStoragePresignedURLEphemeralModel) expandPresignParams(defaults *common.ObjectStorageConfig) *presignParams {
+	method := http.MethodGet
+	if model.Method.ValueString() != "" {
+		method = strings.ToUpper(model.Method.ValueString())
+	}
+	expiration := int64(presignDefaultExpiration)
+	if utils.IsKnown(model.ExpirationSeconds) {
+		expiration = model.ExpirationSeconds.ValueInt64()
+	}
+
+	return &presignParams{
+		ObjectStorageConfig: *resolveS3Config(defaults, model.Endpoint, model.Region, model.AccessKey, model.SecretKey),
+		Bucket:              model.Bucket.ValueString(),
+		Key:                 model.Key.ValueString(),
+		Method:              method,
+		Expiration:          time.Duration(expiration) * time.Second,
+		ResponseHeaders:     model.responseHeaders(),
+	}
+}
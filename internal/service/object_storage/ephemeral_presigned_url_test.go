@@ -0,0 +1,63 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package object_storage_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraEphemeralObjectStoragePresignedURL_basic(t *testing.T) {
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { test.AccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"sakura": providerserver.NewProtocol6WithError(test.AccProvider),
+			"echo":   echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraEphemeralObjectStoragePresignedURL_basic, rand),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("method"), knownvalue.StringExact("GET")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expiration_seconds"), knownvalue.Int64Exact(600)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("region"), knownvalue.StringExact("jp-north-1")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("url"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^https://s3\.isk01\.sakurastorage\.jp/`+rand+`/foo\.txt\?.*X-Amz-Expires=600.*response-content-type=text%2Fplain`))),
+				},
+			},
+		},
+	})
+}
+
+const testAccSakuraEphemeralObjectStoragePresignedURL_basic = `
+ephemeral "sakura_object_storage_presigned_url" "foobar" {
+  access_key            = "dummy-access-key"
+  secret_key            = "dummy-secret-key"
+  bucket                = "{{ .arg0 }}"
+  key                   = "foo.txt"
+  expiration_seconds    = 600
+  response_content_type = "text/plain"
+}
+
+provider "echo" {
+  data = ephemeral.sakura_object_storage_presigned_url.foobar
+}
+
+resource "echo" "test" {}
+`
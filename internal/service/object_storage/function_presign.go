@@ -0,0 +1,119 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package object_storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+)
+
+type objectStoragePresignFunction struct{}
+
+var _ function.Function = &objectStoragePresignFunction{}
+
+func NewObjectStoragePresignFunction() function.Function {
+	return &objectStoragePresignFunction{}
+}
+
+func (f *objectStoragePresignFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "object_storage_presign"
+}
+
+func (f *objectStoragePresignFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Generates a presigned URL of an Object Storage's Object",
+		MarkdownDescription: "Generates a presigned URL of an Object Storage's Object with given credentials. The URL is signed locally against `signing_time` and no API request is sent, so the same arguments always produce the same URL. Use `sakura_object_storage_presigned_url` ephemeral resource instead if you don't want to store the URL in the state.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "access_key",
+				Description: "The access key for the Object Storage",
+			},
+			function.StringParameter{
+				Name:        "secret_key",
+				Description: "The secret key for the Object Storage",
+			},
+			function.StringParameter{
+				Name:           "endpoint",
+				AllowNullValue: true,
+				Description:    "The endpoint of the Object Storage Site. If null, `s3.isk01.sakurastorage.jp` is used",
+			},
+			function.StringParameter{
+				Name:           "region",
+				AllowNullValue: true,
+				Description:    "The region of the Object Storage Site. If null, `jp-north-1` is used",
+			},
+			function.StringParameter{
+				Name:        "bucket",
+				Description: "The name of the Object Storage Bucket",
+			},
+			function.StringParameter{
+				Name:        "key",
+				Description: "The key of the Object Storage Object",
+			},
+			function.StringParameter{
+				Name:        "method",
+				Description: desc.Sprintf("The HTTP method allowed with the presigned URL. This must be one of [%s]", presignMethods),
+				Validators: []function.StringParameterValidator{
+					stringvalidator.OneOf(presignMethods...),
+				},
+			},
+			function.Int64Parameter{
+				Name:        "expiration_seconds",
+				Description: desc.Sprintf("The number of seconds the presigned URL is valid for. %s", desc.Range(1, presignMaxExpiration)),
+				Validators: []function.Int64ParameterValidator{
+					int64validator.Between(1, presignMaxExpiration),
+				},
+			},
+			function.StringParameter{
+				Name:        "signing_time",
+				Description: "The time to sign the presigned URL at, in RFC3339 format. The URL is valid from this time for `expiration_seconds`. Use a fixed value such as `time_static` resource's `rfc3339`, because `timestamp()` changes on every run",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *objectStoragePresignFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var accessKey, secretKey, bucket, key, method, signingTime string
+	var endpoint, region types.String
+	var expiration int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &accessKey, &secretKey, &endpoint, &region, &bucket, &key, &method, &expiration, &signingTime))
+	if resp.Error != nil {
+		return
+	}
+
+	t, err := time.Parse(time.RFC3339, signingTime)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(8, "signing_time must be in RFC3339 format: "+err.Error())
+		return
+	}
+
+	u, err := presignObjectURL(&presignParams{
+		ObjectStorageConfig: common.ObjectStorageConfig{
+			AccessKey: accessKey,
+			SecretKey: secretKey,
+			Region:    region.ValueString(),
+			Endpoint:  endpoint.ValueString(),
+		},
+		Bucket:      bucket,
+		Key:         key,
+		Method:      method,
+		Expiration:  time.Duration(expiration) * time.Second,
+		SigningTime: t,
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, u.String()))
+}
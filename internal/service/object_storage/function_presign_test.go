@@ -0,0 +1,66 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package object_storage_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraFunctionObjectStoragePresign_basic(t *testing.T) {
+	rand := test.RandomName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { test.AccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraFunctionObjectStoragePresign_basic, rand),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchOutput("get_url", regexp.MustCompile(`^https://s3\.isk01\.sakurastorage\.jp/`+rand+`/foo\.txt\?.*X-Amz-Date=20260101T000000Z.*X-Amz-Expires=300`)),
+					resource.TestMatchOutput("put_url", regexp.MustCompile(`^https://s3\.tky01\.sakurastorage\.jp/`+rand+`/bar\.txt\?.*X-Amz-Credential=dummy-access-key%2F20260101%2Fjp-east-1%2F`)),
+				),
+			},
+			{
+				Config:      test.BuildConfigWithArgs(testAccSakuraFunctionObjectStoragePresign_invalidMethod, rand),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config:      test.BuildConfigWithArgs(testAccSakuraFunctionObjectStoragePresign_invalidSigningTime, rand),
+				ExpectError: regexp.MustCompile(`signing_time must be in RFC3339 format`),
+			},
+		},
+	})
+}
+
+const testAccSakuraFunctionObjectStoragePresign_basic = `
+output "get_url" {
+  value     = provider::sakura::object_storage_presign("dummy-access-key", "dummy-secret-key", null, null, "{{ .arg0 }}", "foo.txt", "GET", 300, "2026-01-01T00:00:00Z")
+  sensitive = true
+}
+
+output "put_url" {
+  value     = provider::sakura::object_storage_presign("dummy-access-key", "dummy-secret-key", "s3.tky01.sakurastorage.jp", "jp-east-1", "{{ .arg0 }}", "bar.txt", "PUT", 300, "2026-01-01T00:00:00Z")
+  sensitive = true
+}
+`
+
+const testAccSakuraFunctionObjectStoragePresign_invalidMethod = `
+output "url" {
+  value = provider::sakura::object_storage_presign("dummy-access-key", "dummy-secret-key", null, null, "{{ .arg0 }}", "foo.txt", "DELETE", 300, "2026-01-01T00:00:00Z")
+}
+`
+
+const testAccSakuraFunctionObjectStoragePresign_invalidSigningTime = `
+output "url" {
+  value = provider::sakura::object_storage_presign("dummy-access-key", "dummy-secret-key", null, null, "{{ .arg0 }}", "foo.txt", "GET", 300, "2026-01-01")
+}
+`
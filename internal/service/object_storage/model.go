@@ -4,11 +4,14 @@
 package object_storage
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
 	ver "github.com/sacloud/terraform-provider-sakura/version"
 )
 
@@ -71,16 +74,18 @@ type objectStorageObjectBaseModel struct {
 	StorageClass types.String `tfsdk:"storage_class"`
 }
 
-func (model *objectStorageS3CompatModel) updateS3State() {
+func (model *objectStorageS3CompatModel) updateS3State(defaults *common.ObjectStorageConfig) {
+	conf := model.s3Config(defaults)
 	model.ID = types.StringValue(model.Bucket.ValueString())
-	model.Region = types.StringValue(getRegion(model.Region.ValueString()))
-	model.Endpoint = types.StringValue(getEndpoint(model.Endpoint.ValueString()))
+	model.Region = types.StringValue(getRegion(conf.Region))
+	model.Endpoint = types.StringValue(getEndpoint(conf.Endpoint))
 }
 
-func (model *objectStorageObjectBaseModel) updateState(objInfo *minio.ObjectInfo) {
+func (model *objectStorageObjectBaseModel) updateState(objInfo *minio.ObjectInfo, defaults *common.ObjectStorageConfig) {
+	conf := model.s3Config(defaults)
 	model.ID = types.StringValue(model.Bucket.ValueString() + "/" + model.Key.ValueString())
-	model.Region = types.StringValue(getRegion(model.Region.ValueString()))
-	model.Endpoint = types.StringValue(getEndpoint(model.Endpoint.ValueString()))
+	model.Region = types.StringValue(getRegion(conf.Region))
+	model.Endpoint = types.StringValue(getEndpoint(conf.Endpoint))
 	model.ETag = types.StringValue(objInfo.ETag)
 	model.LastModified = types.StringValue(objInfo.LastModified.String())
 	model.VersionID = types.StringValue(objInfo.VersionID)
@@ -102,15 +107,66 @@ func getEndpoint(endpoint string) string {
 	return endpoint
 }
 
-func (model *objectStorageS3CompatModel) getMinIOClient() (*minio.Client, error) {
-	endpoint := getEndpoint(model.Endpoint.ValueString())
-	client, err := minio.New(endpoint, &minio.Options{
-		Creds:  credentials.NewStaticV4(model.AccessKey.ValueString(), model.SecretKey.ValueString(), ""),
-		Region: model.Region.ValueString(), Secure: true, BucketLookup: minio.BucketLookupPath,
-	})
+// 各リソースで未指定の値はプロバイダーのobject_storageの設定値を利用する
+func (model *objectStorageS3CompatModel) s3Config(defaults *common.ObjectStorageConfig) *common.ObjectStorageConfig {
+	return resolveS3Config(defaults, model.Endpoint, model.Region, model.AccessKey, model.SecretKey)
+}
+
+func resolveS3Config(defaults *common.ObjectStorageConfig, endpoint, region, accessKey, secretKey types.String) *common.ObjectStorageConfig {
+	conf := &common.ObjectStorageConfig{
+		AccessKey: accessKey.ValueString(),
+		SecretKey: secretKey.ValueString(),
+		Region:    region.ValueString(),
+		Endpoint:  endpoint.ValueString(),
+	}
+	if defaults != nil {
+		conf.FillWith(defaults)
+	}
+	return conf
+}
+
+func (model *objectStorageS3CompatModel) getMinIOClient(defaults *common.ObjectStorageConfig) (*minio.Client, error) {
+	return newMinIOClient(model.s3Config(defaults))
+}
+
+// NewMinIOClientWithDefaults は他サービスのリソースからObject Storageのオブジェクトを参照するためのMinIOクライアントを返す。
+// 未指定の値はプロバイダーのobject_storageの設定値を利用する
+func NewMinIOClientWithDefaults(defaults *common.ObjectStorageConfig, endpoint, region, accessKey, secretKey types.String) (*minio.Client, error) {
+	return newMinIOClient(resolveS3Config(defaults, endpoint, region, accessKey, secretKey))
+}
+
+func newMinIOClient(conf *common.ObjectStorageConfig) (*minio.Client, error) {
+	if conf.AccessKey == "" || conf.SecretKey == "" {
+		return nil, errors.New("access_key and secret_key are required. Specify them in the resource or object_storage block of the provider configuration")
+	}
+
+	endpoint, secure := parseEndpoint(getEndpoint(conf.Endpoint), conf.Insecure)
+	opts := &minio.Options{
+		Creds:  credentials.NewStaticV4(conf.AccessKey, conf.SecretKey, ""),
+		Region: conf.Region, Secure: secure, BucketLookup: minio.BucketLookupPath,
+	}
+	if conf.ReadOnly {
+		transport, err := minio.DefaultTransport(secure)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create MinIO transport: %w", err)
+		}
+		opts.Transport = common.NewReadOnlyTransport(transport)
+	}
+	client, err := minio.New(endpoint, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create MinIO client: %w", err)
 	}
 	client.SetAppInfo("terraform-provider-sakura", ver.Version)
 	return client, nil
 }
+
+// endpointに付与されたschemeはinsecureより優先する
+func parseEndpoint(endpoint string, insecure bool) (string, bool) {
+	secure := !insecure
+	if after, ok := strings.CutPrefix(endpoint, "http://"); ok {
+		endpoint, secure = after, false
+	} else if after, ok := strings.CutPrefix(endpoint, "https://"); ok {
+		endpoint, secure = after, true
+	}
+	return strings.TrimSuffix(endpoint, "/"), secure
+}
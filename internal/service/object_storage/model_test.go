@@ -0,0 +1,56 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package object_storage
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEndpoint(t *testing.T) {
+	cases := []struct {
+		endpoint string
+		insecure bool
+		host     string
+		secure   bool
+	}{
+		{endpoint: "s3.isk01.sakurastorage.jp", host: "s3.isk01.sakurastorage.jp", secure: true},
+		{endpoint: "localhost:9000", insecure: true, host: "localhost:9000", secure: false},
+		{endpoint: "http://localhost:9000/", host: "localhost:9000", secure: false},
+		{endpoint: "https://s3.example.com", insecure: true, host: "s3.example.com", secure: true},
+	}
+	for _, tc := range cases {
+		host, secure := parseEndpoint(tc.endpoint, tc.insecure)
+		require.Equal(t, tc.host, host, tc.endpoint)
+		require.Equal(t, tc.secure, secure, tc.endpoint)
+	}
+}
+
+func TestResolveS3Config(t *testing.T) {
+	defaults := &common.ObjectStorageConfig{
+		AccessKey: "provider-access-key",
+		SecretKey: "provider-secret-key",
+		Region:    "provider-region",
+		Endpoint:  "http://localhost:9000",
+		Insecure:  true,
+	}
+
+	conf := resolveS3Config(defaults, types.StringNull(), types.StringValue("jp-north-1"), types.StringNull(), types.StringNull())
+	require.Equal(t, &common.ObjectStorageConfig{
+		AccessKey: "provider-access-key",
+		SecretKey: "provider-secret-key",
+		Region:    "jp-north-1",
+		Endpoint:  "http://localhost:9000",
+		Insecure:  true,
+	}, conf)
+
+	conf = resolveS3Config(nil, types.StringNull(), types.StringNull(), types.StringValue("ak"), types.StringValue("sk"))
+	require.Equal(t, &common.ObjectStorageConfig{AccessKey: "ak", SecretKey: "sk"}, conf)
+
+	_, err := newMinIOClient(resolveS3Config(nil, types.StringNull(), types.StringNull(), types.StringNull(), types.StringNull()))
+	require.Error(t, err)
+}
@@ -18,10 +18,13 @@
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
 )
 
-type objectStorageBucketCorsResource struct{}
+type objectStorageBucketCorsResource struct {
+	s3Defaults *common.ObjectStorageConfig
+}
 
 var (
 	_ resource.Resource                = &objectStorageBucketCorsResource{}
+	_ resource.ResourceWithConfigure   = &objectStorageBucketCorsResource{}
 	_ resource.ResourceWithImportState = &objectStorageBucketCorsResource{}
 )
 
@@ -33,6 +36,14 @@ func (r *objectStorageBucketCorsResource) Metadata(_ context.Context, req resour
 	resp.TypeName = req.ProviderTypeName + "_object_storage_bucket_cors"
 }
 
+func (r *objectStorageBucketCorsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.s3Defaults = &apiclient.ObjectStorageConfig
+}
+
 type objectStorageBucketCorsResourceModel struct {
 	objectStorageS3CompatModel
 	CorsRules []*objectStorageBucketCorsRuleModel `tfsdk:"cors_rules"`
@@ -119,13 +130,13 @@ func (r *objectStorageBucketCorsResource) Create(ctx context.Context, req resour
 	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
 	defer cancel()
 
-	corsConf, err := setBucketCorsConfiguration(ctx, &plan)
+	corsConf, err := setBucketCorsConfiguration(ctx, &plan, r.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Create: API Error", err.Error())
 		return
 	}
 
-	plan.updateS3State()
+	plan.updateS3State(r.s3Defaults)
 	plan.CorsRules = flattenCorsConfiguration(corsConf)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -137,7 +148,7 @@ func (r *objectStorageBucketCorsResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	client, err := state.getMinIOClient()
+	client, err := state.getMinIOClient(r.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Read: Client Error", fmt.Sprintf("failed to create MinIO client: %s", err))
 		return
@@ -149,7 +160,7 @@ func (r *objectStorageBucketCorsResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	state.updateS3State()
+	state.updateS3State(r.s3Defaults)
 	state.CorsRules = flattenCorsConfiguration(corsConf)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -164,13 +175,13 @@ func (r *objectStorageBucketCorsResource) Update(ctx context.Context, req resour
 	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
 	defer cancel()
 
-	corsConf, err := setBucketCorsConfiguration(ctx, &plan)
+	corsConf, err := setBucketCorsConfiguration(ctx, &plan, r.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Update: API Error", err.Error())
 		return
 	}
 
-	plan.updateS3State()
+	plan.updateS3State(r.s3Defaults)
 	plan.CorsRules = flattenCorsConfiguration(corsConf)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -182,7 +193,7 @@ func (r *objectStorageBucketCorsResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	client, err := state.getMinIOClient()
+	client, err := state.getMinIOClient(r.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete: Client Error", fmt.Sprintf("failed to create MinIO client: %s", err))
 		return
@@ -195,8 +206,8 @@ func (r *objectStorageBucketCorsResource) Delete(ctx context.Context, req resour
 	}
 }
 
-func setBucketCorsConfiguration(ctx context.Context, model *objectStorageBucketCorsResourceModel) (*cors.Config, error) {
-	client, err := model.getMinIOClient()
+func setBucketCorsConfiguration(ctx context.Context, model *objectStorageBucketCorsResourceModel, defaults *common.ObjectStorageConfig) (*cors.Config, error) {
+	client, err := model.getMinIOClient(defaults)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create MinIO client: %w", err)
 	}
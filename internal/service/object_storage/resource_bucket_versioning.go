@@ -18,10 +18,13 @@
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
 )
 
-type objectStorageBucketVersioningResource struct{}
+type objectStorageBucketVersioningResource struct {
+	s3Defaults *common.ObjectStorageConfig
+}
 
 var (
 	_ resource.Resource                = &objectStorageBucketVersioningResource{}
+	_ resource.ResourceWithConfigure   = &objectStorageBucketVersioningResource{}
 	_ resource.ResourceWithImportState = &objectStorageBucketVersioningResource{}
 )
 
@@ -33,6 +36,14 @@ func (r *objectStorageBucketVersioningResource) Metadata(_ context.Context, req
 	resp.TypeName = req.ProviderTypeName + "_object_storage_bucket_versioning"
 }
 
+func (r *objectStorageBucketVersioningResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.s3Defaults = &apiclient.ObjectStorageConfig
+}
+
 type objectStorageBucketVersioningResourceModel struct {
 	objectStorageS3CompatModel
 	VersioningConfiguration *objectStorageBucketVersioningConfigModel `tfsdk:"versioning_configuration"`
@@ -97,12 +108,12 @@ func (r *objectStorageBucketVersioningResource) Create(ctx context.Context, req
 	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
 	defer cancel()
 
-	if err := setBucketVersioningConfiguration(ctx, &plan); err != nil {
+	if err := setBucketVersioningConfiguration(ctx, &plan, r.s3Defaults); err != nil {
 		resp.Diagnostics.AddError("Create: API Error", err.Error())
 		return
 	}
 
-	plan.updateS3State()
+	plan.updateS3State(r.s3Defaults)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -113,7 +124,7 @@ func (r *objectStorageBucketVersioningResource) Read(ctx context.Context, req re
 		return
 	}
 
-	client, err := state.getMinIOClient()
+	client, err := state.getMinIOClient(r.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Read: Client Error", fmt.Sprintf("failed to create MinIO client: %s", err))
 		return
@@ -125,7 +136,7 @@ func (r *objectStorageBucketVersioningResource) Read(ctx context.Context, req re
 		return
 	}
 
-	state.updateS3State()
+	state.updateS3State(r.s3Defaults)
 	state.VersioningConfiguration.Status = types.StringValue(versioningConfig.Status)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -140,12 +151,12 @@ func (r *objectStorageBucketVersioningResource) Update(ctx context.Context, req
 	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
 	defer cancel()
 
-	if err := setBucketVersioningConfiguration(ctx, &plan); err != nil {
+	if err := setBucketVersioningConfiguration(ctx, &plan, r.s3Defaults); err != nil {
 		resp.Diagnostics.AddError("Update: API Error", err.Error())
 		return
 	}
 
-	plan.updateS3State()
+	plan.updateS3State(r.s3Defaults)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -156,7 +167,7 @@ func (r *objectStorageBucketVersioningResource) Delete(ctx context.Context, req
 		return
 	}
 
-	client, err := state.getMinIOClient()
+	client, err := state.getMinIOClient(r.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete: Client Error", fmt.Errorf("failed to create MinIO client: %w", err).Error())
 		return
@@ -169,8 +180,8 @@ func (r *objectStorageBucketVersioningResource) Delete(ctx context.Context, req
 	}
 }
 
-func setBucketVersioningConfiguration(ctx context.Context, model *objectStorageBucketVersioningResourceModel) error {
-	client, err := model.getMinIOClient()
+func setBucketVersioningConfiguration(ctx context.Context, model *objectStorageBucketVersioningResourceModel, defaults *common.ObjectStorageConfig) error {
+	client, err := model.getMinIOClient(defaults)
 	if err != nil {
 		return fmt.Errorf("failed to create MinIO client: %w", err)
 	}
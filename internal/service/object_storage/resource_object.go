@@ -28,10 +28,13 @@
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
 )
 
-type objectStorageObjectResource struct{}
+type objectStorageObjectResource struct {
+	s3Defaults *common.ObjectStorageConfig
+}
 
 var (
 	_ resource.Resource                = &objectStorageObjectResource{}
+	_ resource.ResourceWithConfigure   = &objectStorageObjectResource{}
 	_ resource.ResourceWithImportState = &objectStorageObjectResource{}
 )
 
@@ -43,6 +46,14 @@ func (r *objectStorageObjectResource) Metadata(_ context.Context, req resource.M
 	resp.TypeName = req.ProviderTypeName + "_object_storage_object"
 }
 
+func (r *objectStorageObjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.s3Defaults = &apiclient.ObjectStorageConfig
+}
+
 type objectStorageObjectResourceModel struct {
 	objectStorageObjectBaseModel
 	ACL                  types.String   `tfsdk:"acl"`
@@ -181,7 +192,7 @@ func (r *objectStorageObjectResource) Create(ctx context.Context, req resource.C
 	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
 	defer cancel()
 
-	if err := uploadObject(ctx, &plan); err != nil {
+	if err := uploadObject(ctx, &plan, r.s3Defaults); err != nil {
 		resp.Diagnostics.AddError("Create: API Error", err.Error())
 		return
 	}
@@ -196,7 +207,7 @@ func (r *objectStorageObjectResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	client, err := state.getMinIOClient()
+	client, err := state.getMinIOClient(r.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Read: Client Error", err.Error())
 		return
@@ -208,7 +219,7 @@ func (r *objectStorageObjectResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	state.updateState(&objInfo)
+	state.updateState(&objInfo, r.s3Defaults)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -222,7 +233,7 @@ func (r *objectStorageObjectResource) Update(ctx context.Context, req resource.U
 	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
 	defer cancel()
 
-	if err := uploadObject(ctx, &plan); err != nil {
+	if err := uploadObject(ctx, &plan, r.s3Defaults); err != nil {
 		resp.Diagnostics.AddError("Update Error", err.Error())
 		return
 	}
@@ -240,7 +251,7 @@ func (r *objectStorageObjectResource) Delete(ctx context.Context, req resource.D
 	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
 	defer cancel()
 
-	client, err := state.getMinIOClient()
+	client, err := state.getMinIOClient(r.s3Defaults)
 	if err != nil {
 		resp.Diagnostics.AddError("Delete: Client Error", err.Error())
 		return
@@ -272,7 +283,7 @@ func (r *objectStorageObjectResource) Delete(ctx context.Context, req resource.D
 	}
 }
 
-func uploadObject(ctx context.Context, model *objectStorageObjectResourceModel) error {
+func uploadObject(ctx context.Context, model *objectStorageObjectResourceModel, defaults *common.ObjectStorageConfig) error {
 	var body io.ReadSeeker
 	if model.Source.ValueString() != "" { //nolint:gocritic
 		path, err := common.ExpandHomeDir(model.Source.ValueString())
@@ -302,7 +313,7 @@ func uploadObject(ctx context.Context, model *objectStorageObjectResourceModel)
 		return fmt.Errorf("one of source, content or content_base64 must be specified")
 	}
 
-	client, err := model.getMinIOClient()
+	client, err := model.getMinIOClient(defaults)
 	if err != nil {
 		return err
 	}
@@ -358,7 +369,7 @@ func uploadObject(ctx context.Context, model *objectStorageObjectResourceModel)
 		return fmt.Errorf("failed to get object information: %s", err.Error())
 	}
 
-	model.updateState(&objInfo)
+	model.updateState(&objInfo, defaults)
 
 	return nil
 }
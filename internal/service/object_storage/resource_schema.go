@@ -47,9 +47,9 @@ func SchemaResourceRegion(name string) schema.Attribute {
 
 func SchemaResourceAccessKey(name string) schema.Attribute {
 	return schema.StringAttribute{
-		Required:    true,
+		Optional:    true,
 		Sensitive:   true,
-		Description: desc.Sprintf("The access key for the %s.", name),
+		Description: desc.Sprintf("The access key for the %s. If omitted, `object_storage.access_key` in the provider configuration is used.", name),
 		Validators: []validator.String{
 			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("secret_key")),
 		},
@@ -58,9 +58,9 @@ func SchemaResourceAccessKey(name string) schema.Attribute {
 
 func SchemaResourceSecretKey(name string) schema.Attribute {
 	return schema.StringAttribute{
-		Required:    true,
+		Optional:    true,
 		Sensitive:   true,
-		Description: desc.Sprintf("The secret key for the %s.", name),
+		Description: desc.Sprintf("The secret key for the %s. If omitted, `object_storage.secret_key` in the provider configuration is used.", name),
 		Validators: []validator.String{
 			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("access_key")),
 		},
@@ -27,6 +27,7 @@ type packetFilterResource struct {
 	_ resource.Resource                = &packetFilterResource{}
 	_ resource.ResourceWithConfigure   = &packetFilterResource{}
 	_ resource.ResourceWithImportState = &packetFilterResource{}
+	_ resource.ResourceWithModifyPlan  = &packetFilterResource{}
 )
 
 func NewPacketFilterResource() resource.Resource {
@@ -45,6 +46,10 @@ func (r *packetFilterResource) Configure(ctx context.Context, req resource.Confi
 	r.client = apiclient
 }
 
+func (r *packetFilterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type packetFilterResourceModel struct {
 	packetFilterBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
@@ -36,6 +36,7 @@ type packetFilterRulesResource struct {
 	_ resource.Resource                = &packetFilterRulesResource{}
 	_ resource.ResourceWithConfigure   = &packetFilterRulesResource{}
 	_ resource.ResourceWithImportState = &packetFilterRulesResource{}
+	_ resource.ResourceWithModifyPlan  = &packetFilterRulesResource{}
 )
 
 func NewPacketFilterRulesResource() resource.Resource {
@@ -54,6 +55,10 @@ func (r *packetFilterRulesResource) Configure(ctx context.Context, req resource.
 	r.client = apiclient
 }
 
+func (r *packetFilterRulesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type packetFilterRulesResourceModel struct {
 	ID             types.String                  `tfsdk:"id"`
 	Zone           types.String                  `tfsdk:"zone"`
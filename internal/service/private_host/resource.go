@@ -37,6 +37,7 @@ type privateHostResource struct {
 	_ resource.Resource                = &privateHostResource{}
 	_ resource.ResourceWithConfigure   = &privateHostResource{}
 	_ resource.ResourceWithImportState = &privateHostResource{}
+	_ resource.ResourceWithModifyPlan  = &privateHostResource{}
 )
 
 func NewPrivateHostResource() resource.Resource {
@@ -55,6 +56,10 @@ func (r *privateHostResource) Configure(ctx context.Context, req resource.Config
 	r.client = apiclient
 }
 
+func (r *privateHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type privateHostResourceModel struct {
 	privateHostBaseModel
 	DedicatedStorageID types.String   `tfsdk:"dedicated_storage_id"`
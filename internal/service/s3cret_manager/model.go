@@ -4,9 +4,16 @@
 package secret_manager
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	eventbusv1 "github.com/sacloud/eventbus-api-go/apis/v1"
 	v1 "github.com/sacloud/secretmanager-api-go/apis/v1"
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
 )
 
 type secretManagerBaseModel struct {
@@ -25,3 +32,58 @@ type secretManagerSecretBaseModel struct {
 	Version types.Int64  `tfsdk:"version"`
 	Value   types.String `tfsdk:"value"`
 }
+
+type secretManagerSecretRotationModel struct {
+	RotationDays types.Int32  `tfsdk:"rotation_days"`
+	WorkflowID   types.String `tfsdk:"workflow_id"`
+	ScheduleID   types.String `tfsdk:"schedule_id"`
+}
+
+// updateRotationState はシークレットのバージョンが変わった時点をローテーション日時として記録する。
+// APIはバージョンの作成日時を返さないため、外部でローテーションされた場合はプロバイダが変更を検知した日時になる
+func (model *secretManagerSecretResourceModel) updateRotationState(prev *secretManagerSecretResourceModel, version int, now time.Time) {
+	model.Version = types.Int64Value(int64(version))
+	if prev == nil || !utils.IsKnown(prev.LastRotatedAt) || prev.Version.ValueInt64() != int64(version) {
+		model.LastRotatedAt = types.StringValue(now.UTC().Format(time.RFC3339))
+	} else {
+		model.LastRotatedAt = prev.LastRotatedAt
+	}
+}
+
+// rotationScheduleInterval はEventBusスケジュールの実行間隔を返す。crontab形式のスケジュールは間隔を特定できないためエラーとする
+func rotationScheduleInterval(schedule *eventbusv1.ScheduleSettings) (time.Duration, error) {
+	step, ok := schedule.RecurringStep.Get()
+	if !ok || !schedule.RecurringUnit.IsSet() {
+		return 0, errors.New("the schedule must be configured with recurring_step and recurring_unit instead of crontab")
+	}
+	switch schedule.RecurringUnit.Value {
+	case eventbusv1.ScheduleSettingsRecurringUnitMin:
+		return time.Duration(step) * time.Minute, nil
+	case eventbusv1.ScheduleSettingsRecurringUnitHour:
+		return time.Duration(step) * time.Hour, nil
+	case eventbusv1.ScheduleSettingsRecurringUnitDay:
+		return time.Duration(step) * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("unsupported recurring_unit: %s", schedule.RecurringUnit.Value)
+	}
+}
+
+// nextRotationScheduleRun はEventBusスケジュールのnow以降の次回実行日時を返す
+func nextRotationScheduleRun(schedule *eventbusv1.ScheduleSettings, interval time.Duration, now time.Time) (time.Time, error) {
+	var startsAtMillis int64
+	switch v := schedule.StartsAt; {
+	case v.IsInt64():
+		startsAtMillis = v.Int64
+	case v.IsString():
+		parsed, err := strconv.ParseInt(v.String, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid StartsAt value as int64: %w", err)
+		}
+		startsAtMillis = parsed
+	}
+	startsAt := time.UnixMilli(startsAtMillis).UTC()
+	if interval <= 0 || now.Before(startsAt) {
+		return startsAt, nil
+	}
+	return startsAt.Add((now.Sub(startsAt)/interval + 1) * interval), nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package secret_manager
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	eventbusv1 "github.com/sacloud/eventbus-api-go/apis/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretManagerSecretUpdateRotationState(t *testing.T) {
+	rotatedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 1, 10, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	prev := &secretManagerSecretResourceModel{
+		secretManagerSecretBaseModel: secretManagerSecretBaseModel{Version: types.Int64Value(1)},
+		LastRotatedAt:                types.StringValue(rotatedAt.Format(time.RFC3339)),
+	}
+
+	t.Run("create", func(t *testing.T) {
+		model := &secretManagerSecretResourceModel{}
+		model.updateRotationState(nil, 1, now)
+		require.Equal(t, types.Int64Value(1), model.Version)
+		require.Equal(t, types.StringValue("2026-01-10T00:00:00Z"), model.LastRotatedAt)
+	})
+
+	t.Run("same version", func(t *testing.T) {
+		model := &secretManagerSecretResourceModel{}
+		model.updateRotationState(prev, 1, now)
+		require.Equal(t, prev.LastRotatedAt, model.LastRotatedAt)
+	})
+
+	t.Run("rotated outside of terraform", func(t *testing.T) {
+		model := &secretManagerSecretResourceModel{}
+		model.updateRotationState(prev, 2, now)
+		require.Equal(t, types.Int64Value(2), model.Version)
+		require.Equal(t, types.StringValue("2026-01-10T00:00:00Z"), model.LastRotatedAt)
+	})
+}
+
+func TestRotationSchedule(t *testing.T) {
+	startsAt := time.Date(2026, 1, 1, 3, 0, 0, 0, time.UTC)
+	schedule := func(step int, unit eventbusv1.ScheduleSettingsRecurringUnit) *eventbusv1.ScheduleSettings {
+		return &eventbusv1.ScheduleSettings{
+			RecurringStep: eventbusv1.NewOptInt(step),
+			RecurringUnit: eventbusv1.NewOptScheduleSettingsRecurringUnit(unit),
+			StartsAt:      eventbusv1.NewStringScheduleSettingsStartsAt(strconv.FormatInt(startsAt.UnixMilli(), 10)),
+		}
+	}
+
+	interval, err := rotationScheduleInterval(schedule(30, eventbusv1.ScheduleSettingsRecurringUnitDay))
+	require.NoError(t, err)
+	require.Equal(t, 30*24*time.Hour, interval)
+	interval, err = rotationScheduleInterval(schedule(48, eventbusv1.ScheduleSettingsRecurringUnitHour))
+	require.NoError(t, err)
+	require.Equal(t, 2*24*time.Hour, interval)
+
+	// crontab形式のスケジュールは実行間隔を特定できない
+	_, err = rotationScheduleInterval(&eventbusv1.ScheduleSettings{Crontab: eventbusv1.NewOptString("0 3 * * *")})
+	require.ErrorContains(t, err, "instead of crontab")
+
+	s := schedule(30, eventbusv1.ScheduleSettingsRecurringUnitDay)
+	next, err := nextRotationScheduleRun(s, 30*24*time.Hour, startsAt.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, startsAt, next)
+	next, err = nextRotationScheduleRun(s, 30*24*time.Hour, startsAt)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 1, 31, 3, 0, 0, 0, time.UTC), next)
+	next, err = nextRotationScheduleRun(s, 30*24*time.Hour, time.Date(2026, 2, 15, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2026, 3, 2, 3, 0, 0, 0, time.UTC), next)
+}
+
+func TestIsSecretValueChanged(t *testing.T) {
+	state := &secretManagerSecretResourceModel{
+		secretManagerSecretBaseModel: secretManagerSecretBaseModel{
+			Name:    types.StringValue("foo"),
+			VaultID: types.StringValue("123"),
+			Value:   types.StringNull(),
+		},
+		ValueWOVersion: types.Int32Value(1),
+	}
+
+	plan := *state
+	plan.Rotation = &secretManagerSecretRotationModel{RotationDays: types.Int32Value(30)}
+	require.False(t, isSecretValueChanged(&plan, state))
+
+	plan.ValueWOVersion = types.Int32Value(2)
+	require.True(t, isSecretValueChanged(&plan, state))
+
+	plan = *state
+	plan.Name = types.StringValue("bar")
+	require.True(t, isSecretValueChanged(&plan, state))
+}
@@ -9,6 +9,7 @@
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
@@ -20,20 +21,27 @@
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/eventbus-api-go"
+	eventbusv1 "github.com/sacloud/eventbus-api-go/apis/v1"
 	sm "github.com/sacloud/secretmanager-api-go"
 	v1 "github.com/sacloud/secretmanager-api-go/apis/v1"
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
 	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+	"github.com/sacloud/workflows-api-go"
+	workflowsv1 "github.com/sacloud/workflows-api-go/apis/v1"
 )
 
 type secretManagerSecretResource struct {
-	client *v1.Client
+	client          *v1.Client
+	workflowsClient *workflowsv1.Client
+	eventbusClient  *eventbusv1.Client
 }
 
 var (
-	_ resource.Resource                = &secretManagerSecretResource{}
-	_ resource.ResourceWithConfigure   = &secretManagerSecretResource{}
-	_ resource.ResourceWithImportState = &secretManagerSecretResource{}
+	_ resource.Resource                   = &secretManagerSecretResource{}
+	_ resource.ResourceWithConfigure      = &secretManagerSecretResource{}
+	_ resource.ResourceWithImportState    = &secretManagerSecretResource{}
+	_ resource.ResourceWithValidateConfig = &secretManagerSecretResource{}
 )
 
 func NewSecretManagerSecretResource() resource.Resource {
@@ -50,13 +58,18 @@ func (r *secretManagerSecretResource) Configure(ctx context.Context, req resourc
 		return
 	}
 	r.client = apiclient.SecretManagerClient
+	r.workflowsClient = apiclient.WorkflowsClient
+	r.eventbusClient = apiclient.EventBusClient
 }
 
 type secretManagerSecretResourceModel struct {
 	secretManagerSecretBaseModel
-	ValueWO        types.String   `tfsdk:"value_wo"`
-	ValueWOVersion types.Int32    `tfsdk:"value_wo_version"`
-	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	ValueWO        types.String                      `tfsdk:"value_wo"`
+	ValueWOVersion types.Int32                       `tfsdk:"value_wo_version"`
+	Rotation       *secretManagerSecretRotationModel `tfsdk:"rotation"`
+	LastRotatedAt  types.String                      `tfsdk:"last_rotated_at"`
+	NextRotationAt types.String                      `tfsdk:"next_rotation_at"`
+	Timeouts       timeouts.Value                    `tfsdk:"timeouts"`
 }
 
 func (r *secretManagerSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -103,11 +116,47 @@ func (r *secretManagerSecretResource) Schema(ctx context.Context, req resource.S
 					int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("value_wo")),
 				},
 			},
+			"rotation": schema.SingleNestedAttribute{
+				Optional:    true,
+				Description: "The rotation configuration of the secret. The rotation itself is executed by the Workflow or the EventBus Schedule, which stores a new version of the secret. Terraform doesn't create or start them.",
+				Attributes: map[string]schema.Attribute{
+					"rotation_days": schema.Int32Attribute{
+						Optional:    true,
+						Description: "The interval of the rotation in days. This must match the interval of the EventBus Schedule specified by `schedule_id`, and is required with it.",
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+							int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("schedule_id")),
+						},
+					},
+					"workflow_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "The ID of the Workflow which rotates the secret. Either `workflow_id` or `schedule_id` is required. The Workflow must be started outside of Terraform, so `next_rotation_at` is null.",
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("schedule_id")),
+						},
+					},
+					"schedule_id": schema.StringAttribute{
+						Optional:    true,
+						Description: "The ID of the EventBus Schedule which rotates the secret. The schedule must be configured with `recurring_step` and `recurring_unit`",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("rotation_days")),
+						},
+					},
+				},
+			},
+			"last_rotated_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time when the version of the secret was last changed, in RFC3339 format. When the secret is rotated outside of Terraform, this is the time when the provider detected the new version.",
+			},
+			"next_rotation_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time of the next run of the EventBus Schedule specified by `rotation.schedule_id`, in RFC3339 format. This is null unless `rotation.schedule_id` is configured.",
+			},
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true, Update: true, Delete: true,
 			}),
 		},
-		MarkdownDescription: "Manages a Secret Manager's secret.",
+		MarkdownDescription: "Manages a Secret Manager's secret.\n\nThe new versions stored outside of Terraform, e.g. by the rotation, are tracked in `version` and `last_rotated_at` without reporting drift. Terraform stores a new version only when `name`, `vault_id`, `value` or `value_wo_version` is changed.",
 	}
 }
 
@@ -141,6 +190,20 @@ func (r *secretManagerSecretResource) ImportState(ctx context.Context, req resou
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
 }
 
+func (r *secretManagerSecretResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config secretManagerSecretResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// valueはステートに保存されるため、ローテーション後の値と食い違う
+	if config.Rotation != nil && !config.Value.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid Attribute Combination",
+			"value can't be used with rotation. Use value_wo instead")
+	}
+}
+
 func (r *secretManagerSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan, config secretManagerSecretResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -157,6 +220,10 @@ func (r *secretManagerSecretResource) Create(ctx context.Context, req resource.C
 		resp.Diagnostics.AddError("Create: Attribute Error", fmt.Sprintf("invalid secret value: %s", err))
 		return
 	}
+	if err := r.updateNextRotationAt(ctx, &plan, time.Now(), true); err != nil {
+		resp.Diagnostics.AddError("Create: API Error", err.Error())
+		return
+	}
 
 	secretOp := sm.NewSecretOp(r.client, plan.VaultID.ValueString())
 	createdSec, err := secretOp.Create(ctx, v1.CreateSecret{
@@ -169,7 +236,7 @@ func (r *secretManagerSecretResource) Create(ctx context.Context, req resource.C
 	}
 
 	plan.Name = types.StringValue(createdSec.Name)
-	plan.Version = types.Int64Value(int64(createdSec.LatestVersion))
+	plan.updateRotationState(nil, createdSec.LatestVersion, time.Now())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -185,15 +252,21 @@ func (r *secretManagerSecretResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	prev := state
 	state.Name = types.StringValue(secret.Name)
-	state.Version = types.Int64Value(int64(secret.LatestVersion))
+	state.updateRotationState(&prev, secret.LatestVersion, time.Now())
+	// スケジュールはEventBus側のリソースのため、削除されていてもシークレットのRead自体は失敗させない
+	if err := r.updateNextRotationAt(ctx, &state, time.Now(), false); err != nil {
+		resp.Diagnostics.AddWarning("Read: API Error", fmt.Sprintf("%s. Set null to next_rotation_at attribute", err))
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *secretManagerSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan, config secretManagerSecretResourceModel
+	var plan, config, state secretManagerSecretResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -201,6 +274,19 @@ func (r *secretManagerSecretResource) Update(ctx context.Context, req resource.U
 	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout5min)
 	defer cancel()
 
+	if err := r.updateNextRotationAt(ctx, &plan, time.Now(), true); err != nil {
+		resp.Diagnostics.AddError("Update: API Error", err.Error())
+		return
+	}
+
+	// rotationやtimeoutsの変更だけでローテーション済みの値を上書きしないよう、値に関わる変更がある場合のみ新しいバージョンを作成する
+	if !isSecretValueChanged(&plan, &state) {
+		plan.Name = state.Name
+		plan.updateRotationState(&state, int(state.Version.ValueInt64()), time.Now())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
 	value, err := getValue(&plan, &config)
 	if err != nil {
 		resp.Diagnostics.AddError("Update: Attribute Error", fmt.Sprintf("invalid secret value: %s", err))
@@ -218,7 +304,7 @@ func (r *secretManagerSecretResource) Update(ctx context.Context, req resource.U
 	}
 
 	plan.Name = types.StringValue(createdSec.Name)
-	plan.Version = types.Int64Value(int64(createdSec.LatestVersion))
+	plan.updateRotationState(&state, createdSec.LatestVersion, time.Now())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -295,3 +381,57 @@ func getValue(plan, config *secretManagerSecretResourceModel) (string, error) {
 		return plan.Value.ValueString(), nil
 	}
 }
+
+func isSecretValueChanged(plan, state *secretManagerSecretResourceModel) bool {
+	return !plan.Name.Equal(state.Name) ||
+		!plan.VaultID.Equal(state.VaultID) ||
+		!plan.Value.Equal(state.Value) ||
+		!plan.ValueWOVersion.Equal(state.ValueWOVersion)
+}
+
+// updateNextRotationAt はローテーションを実行するEventBusスケジュールの次回実行日時をnext_rotation_atに設定する。
+// validateがtrueの場合はWorkflowの存在とスケジュールの実行間隔がrotation_daysと一致することを確認する。
+// エラーを返す場合、next_rotation_atはnullのままとなる
+func (r *secretManagerSecretResource) updateNextRotationAt(ctx context.Context, model *secretManagerSecretResourceModel, now time.Time, validate bool) error {
+	model.NextRotationAt = types.StringNull()
+	rotation := model.Rotation
+	if rotation == nil {
+		return nil
+	}
+
+	if id := rotation.WorkflowID.ValueString(); id != "" && validate {
+		if _, err := workflows.NewWorkflowOp(r.workflowsClient).Read(ctx, id); err != nil {
+			return fmt.Errorf("failed to read Workflow[%s] for rotation: %s", id, err)
+		}
+	}
+
+	id := rotation.ScheduleID.ValueString()
+	if id == "" {
+		return nil
+	}
+	item, err := eventbus.NewScheduleOp(r.eventbusClient).Read(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to read EventBus Schedule[%s] for rotation: %s", id, err)
+	}
+	schedule, ok := item.Settings.GetScheduleSettings()
+	if !ok {
+		return fmt.Errorf("invalid settings for EventBus Schedule[%s]", id)
+	}
+	interval, err := rotationScheduleInterval(&schedule)
+	if err != nil {
+		if validate {
+			return fmt.Errorf("EventBus Schedule[%s] can't be used for rotation: %s", id, err)
+		}
+		return nil
+	}
+	if days := rotation.RotationDays.ValueInt32(); validate && interval != time.Duration(days)*24*time.Hour {
+		return fmt.Errorf("the interval of EventBus Schedule[%s] (%s) doesn't match rotation_days (%d)", id, interval, days)
+	}
+
+	next, err := nextRotationScheduleRun(&schedule, interval, now)
+	if err != nil {
+		return fmt.Errorf("invalid settings for EventBus Schedule[%s]: %s", id, err)
+	}
+	model.NextRotationAt = types.StringValue(next.Format(time.RFC3339))
+	return nil
+}
@@ -85,6 +85,57 @@ func TestAccSakuraSecretManagerSecret_basicWithWO(t *testing.T) {
 	})
 }
 
+func TestAccSakuraSecretManagerSecret_rotation(t *testing.T) {
+	resourceName := "sakura_secret_manager_secret.foobar"
+	rand := test.RandomName()
+
+	var secret v1.Secret
+	var vaultID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraSecretManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraSecretManagerSecret_rotation, rand, "30"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraSecretManagerSecretExists(resourceName, &secret),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.rotation_days", "30"),
+					resource.TestCheckResourceAttrPair(resourceName, "rotation.schedule_id", "sakura_eventbus_schedule.foobar", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_rotated_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_at"),
+					func(s *terraform.State) error {
+						vaultID = s.RootModule().Resources[resourceName].Primary.Attributes["vault_id"]
+						return nil
+					},
+				),
+			},
+			{
+				// 外部でのローテーションをシミュレートする。新しいバージョンはドリフトとして扱われない
+				PreConfig: func() {
+					client := test.AccClientGetter()
+					secretOp := sm.NewSecretOp(client.SecretManagerClient, vaultID)
+					if _, err := secretOp.Create(context.Background(), v1.CreateSecret{Name: rand, Value: "rotated"}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   test.BuildConfigWithArgs(testAccSakuraSecretManagerSecret_rotation, rand, "30"),
+				PlanOnly: true,
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraSecretManagerSecret_rotation, rand, "7"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraSecretManagerSecretExists(resourceName, &secret),
+					// rotationの変更だけでは新しいバージョンは作成されない
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.rotation_days", "7"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccImportSakuraSecretManagerSecret_basic(t *testing.T) {
 	resourceName := "sakura_secret_manager_secret.foobar"
 	rand := test.RandomName()
@@ -104,6 +155,7 @@ func TestAccImportSakuraSecretManagerSecret_basic(t *testing.T) {
 				ImportStateVerifyIdentifierAttribute: "name",
 				ImportStateVerifyIgnore: []string{
 					"value",
+					"last_rotated_at",
 				},
 				ImportStateIdFunc: func(s *terraform.State) (string, error) {
 					rs, ok := s.RootModule().Resources[resourceName]
@@ -165,6 +217,51 @@ func testCheckSakuraSecretManagerSecretExists(n string, secret *v1.Secret) resou
 	}
 }
 
+//nolint:gosec
+var testAccSakuraSecretManagerSecret_rotation = `
+resource "sakura_kms" "foobar" {
+  name        = "{{ .arg0 }}"
+  description = "description"
+}
+
+resource "sakura_secret_manager" "foobar" {
+  name        = "{{ .arg0 }}"
+  description = "description"
+  kms_key_id  = sakura_kms.foobar.id
+}
+
+resource "sakura_eventbus_process_configuration" "foobar" {
+  name        = "{{ .arg0 }}"
+  description = "description"
+
+  destination = "simplenotification"
+  parameters  = "{\"group_id\": \"123456789012\", \"message\":\"rotate secret\"}"
+
+  sakura_access_token_wo        = "test"
+  sakura_access_token_secret_wo = "test"
+  credentials_wo_version        = 1
+}
+
+resource "sakura_eventbus_schedule" "foobar" {
+  name                     = "{{ .arg0 }}"
+  process_configuration_id = sakura_eventbus_process_configuration.foobar.id
+  recurring_step           = {{ .arg1 }}
+  recurring_unit           = "day"
+  starts_at                = 1700000000000
+}
+
+resource "sakura_secret_manager_secret" "foobar" {
+  name             = "{{ .arg0 }}"
+  vault_id         = sakura_secret_manager.foobar.id
+  value_wo         = "value1"
+  value_wo_version = 1
+
+  rotation = {
+    rotation_days = {{ .arg1 }}
+    schedule_id   = sakura_eventbus_schedule.foobar.id
+  }
+}`
+
 //nolint:gosec
 var testAccSakuraSecretManagerSecret_import = `
 resource "sakura_kms" "foobar" {
@@ -37,6 +37,7 @@ type segResource struct {
 	_ resource.Resource                = &segResource{}
 	_ resource.ResourceWithConfigure   = &segResource{}
 	_ resource.ResourceWithImportState = &segResource{}
+	_ resource.ResourceWithModifyPlan  = &segResource{}
 )
 
 func NewSEGResource() resource.Resource {
@@ -55,6 +56,10 @@ func (r *segResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = apiclient
 }
 
+func (r *segResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type segResourceModel struct {
 	segBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
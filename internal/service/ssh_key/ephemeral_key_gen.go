@@ -0,0 +1,185 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh_key
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common/utils"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshKeyTypeED25519    = "ed25519"
+	sshKeyTypeRSA        = "rsa"
+	sshKeyDefaultRSABits = 4096
+	sshKeyMinimumRSABits = 2048
+	sshKeyMaximumRSABits = 8192
+	sshKeyDefaultKeyType = sshKeyTypeED25519
+)
+
+var sshKeyTypes = []string{sshKeyTypeED25519, sshKeyTypeRSA}
+
+type sshKeyGenEphemeralResource struct{}
+
+var (
+	_ ephemeral.EphemeralResource                   = &sshKeyGenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &sshKeyGenEphemeralResource{}
+)
+
+func NewSSHKeyGenEphemeralResource() ephemeral.EphemeralResource {
+	return &sshKeyGenEphemeralResource{}
+}
+
+func (r *sshKeyGenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key_gen"
+}
+
+type sshKeyGenEphemeralModel struct {
+	KeyType           types.String `tfsdk:"key_type"`
+	RSABits           types.Int64  `tfsdk:"rsa_bits"`
+	Comment           types.String `tfsdk:"comment"`
+	PrivateKeyOpenSSH types.String `tfsdk:"private_key_openssh"`
+	PublicKey         types.String `tfsdk:"public_key"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+}
+
+func (r *sshKeyGenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The type of the key. This must be one of [%s]. Default is `%s`.", sshKeyTypes, sshKeyDefaultKeyType),
+				Validators: []validator.String{
+					stringvalidator.OneOf(sshKeyTypes...),
+				},
+			},
+			"rsa_bits": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: desc.Sprintf("The size of the RSA key in bits. %s. Only available with `%s` key_type. Default is `%d`.", desc.Range(sshKeyMinimumRSABits, sshKeyMaximumRSABits), sshKeyTypeRSA, sshKeyDefaultRSABits),
+				Validators: []validator.Int64{
+					int64validator.Between(sshKeyMinimumRSABits, sshKeyMaximumRSABits),
+				},
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "The comment appended to the public key",
+			},
+			"private_key_openssh": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key in OpenSSH format",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key in authorized_keys format. Pass this to `public_key_wo` of `sakura_ssh_key`.",
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "The MD5 fingerprint of the public key, in the same format as `fingerprint` of `sakura_ssh_key`",
+			},
+		},
+		MarkdownDescription: "Generates a SSH key pair for `public_key_wo` of `sakura_ssh_key`.",
+	}
+}
+
+func (r *sshKeyGenEphemeralResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data sshKeyGenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.RSABits.IsNull() && utils.IsKnown(data.KeyType) && data.KeyType.ValueString() != sshKeyTypeRSA {
+		resp.Diagnostics.AddAttributeError(path.Root("rsa_bits"), "Invalid Attribute Combination",
+			fmt.Sprintf("rsa_bits is only available with %q key_type", sshKeyTypeRSA))
+	}
+}
+
+func (r *sshKeyGenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data sshKeyGenEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyType := sshKeyDefaultKeyType
+	if data.KeyType.ValueString() != "" {
+		keyType = data.KeyType.ValueString()
+	}
+	bits := int64(sshKeyDefaultRSABits)
+	if utils.IsKnown(data.RSABits) {
+		bits = data.RSABits.ValueInt64()
+	}
+
+	privateKey, publicKey, fingerprint, err := generateSSHKeyPair(keyType, int(bits), data.Comment.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Open: Key Generation Error", err.Error())
+		return
+	}
+
+	data.KeyType = types.StringValue(keyType)
+	if keyType == sshKeyTypeRSA {
+		data.RSABits = types.Int64Value(bits)
+	} else {
+		data.RSABits = types.Int64Null()
+	}
+	data.PrivateKeyOpenSSH = types.StringValue(privateKey)
+	data.PublicKey = types.StringValue(publicKey)
+	data.Fingerprint = types.StringValue(fingerprint)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// generateSSHKeyPair returns the private key in OpenSSH format, the public key in authorized_keys format and its fingerprint
+func generateSSHKeyPair(keyType string, bits int, comment string) (string, string, string, error) {
+	var privateKey crypto.Signer
+	switch keyType {
+	case sshKeyTypeED25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to generate ed25519 key: %w", err)
+		}
+		privateKey = key
+	case sshKeyTypeRSA:
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to generate RSA key: %w", err)
+		}
+		privateKey = key
+	default:
+		return "", "", "", fmt.Errorf("unsupported key type: %s", keyType)
+	}
+
+	block, err := ssh.MarshalPrivateKey(privateKey, comment)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to marshal private key: %w", err)
+	}
+	publicKey, err := ssh.NewPublicKey(privateKey.Public())
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to marshal public key: %w", err)
+	}
+
+	authorizedKey := strings.TrimSuffix(string(ssh.MarshalAuthorizedKey(publicKey)), "\n")
+	if comment != "" {
+		authorizedKey += " " + comment
+	}
+	return string(pem.EncodeToMemory(block)), authorizedKey, ssh.FingerprintLegacyMD5(publicKey), nil
+}
@@ -8,13 +8,18 @@
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/sacloud/iaas-api-go"
 	iaastypes "github.com/sacloud/iaas-api-go/types"
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
@@ -48,7 +53,9 @@ func (r *sshKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 
 type sshKeyResourceModel struct {
 	sshKeyBaseModel
-	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	PublicKeyWO        types.String   `tfsdk:"public_key_wo"`
+	PublicKeyWOVersion types.Int32    `tfsdk:"public_key_wo_version"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *sshKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -58,10 +65,35 @@ func (r *sshKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, r
 			"name":        common.SchemaResourceName("SSHKey"),
 			"description": common.SchemaResourceDescription("SSHKey"),
 			"public_key": schema.StringAttribute{
-				Required:    true,
-				Description: "The body of the public key.",
+				Optional:    true,
+				Computed:    true,
+				Description: "The body of the public key. Either `public_key` or `public_key_wo` is required.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("public_key_wo")),
+				},
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"public_key_wo": schema.StringAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "The body of the public key. This is useful to register the key generated by `sakura_ssh_key_gen` ephemeral resource.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("public_key")),
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("public_key_wo_version")),
+				},
+			},
+			"public_key_wo_version": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The version of the public_key_wo field. This value must be greater than 0 when set. Increment this to register a new key, which replaces the SSHKey.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("public_key_wo")),
+				},
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
 				},
 			},
 			"fingerprint": schema.StringAttribute{
@@ -81,8 +113,9 @@ func (r *sshKeyResource) ImportState(ctx context.Context, req resource.ImportSta
 }
 
 func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan sshKeyResourceModel
+	var plan, config sshKeyResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -90,11 +123,16 @@ func (r *sshKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout5min)
 	defer cancel()
 
+	publicKey := plan.PublicKey.ValueString()
+	if !config.PublicKeyWO.IsNull() {
+		publicKey = config.PublicKeyWO.ValueString()
+	}
+
 	sshKeyOp := iaas.NewSSHKeyOp(r.client)
 	key, err := sshKeyOp.Create(ctx, &iaas.SSHKeyCreateRequest{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueString(),
-		PublicKey:   plan.PublicKey.ValueString(),
+		PublicKey:   publicKey,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to create SSHKey: %s", err.Error()))
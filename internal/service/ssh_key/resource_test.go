@@ -7,10 +7,12 @@
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/sacloud/iaas-api-go"
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
 	"github.com/sacloud/terraform-provider-sakura/internal/test"
@@ -48,6 +50,33 @@ func TestAccSakuraSSHKey_basic(t *testing.T) {
 	})
 }
 
+func TestAccSakuraSSHKey_withKeyGen(t *testing.T) {
+	resourceName := "sakura_ssh_key.foobar"
+	rand := test.RandomName()
+
+	var sshKey iaas.SSHKey
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { test.AccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraSSHKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraSSHKey_withKeyGen, rand),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSakuraSSHKeyExists(resourceName, &sshKey),
+					resource.TestCheckResourceAttr(resourceName, "name", rand),
+					resource.TestMatchResourceAttr(resourceName, "public_key", regexp.MustCompile(`^ssh-ed25519 `)),
+					resource.TestCheckResourceAttrSet(resourceName, "fingerprint"),
+					resource.TestCheckNoResourceAttr(resourceName, "public_key_wo"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccImportSakuraSSHKey_basic(t *testing.T) {
 	resourceName := "sakura_ssh_key.foobar"
 	rand := test.RandomName()
@@ -140,6 +169,17 @@ func testCheckSakuraSSHKeyDestroy(s *terraform.State) error {
   description = "description-upd"
 }`, testAccPublicKeyUpd)
 
+var testAccSakuraSSHKey_withKeyGen = `
+ephemeral "sakura_ssh_key_gen" "foobar" {
+  comment = "{{ .arg0 }}"
+}
+
+resource "sakura_ssh_key" "foobar" {
+  name                  = "{{ .arg0 }}"
+  public_key_wo         = ephemeral.sakura_ssh_key_gen.foobar.public_key
+  public_key_wo_version = 1
+}`
+
 const testAccPublicKey = `ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDq94EJW1+KAQLHNLC1KKdJq2aTIg/FSYeuKBiA7HWsCeG384uPo9afBS/+flXZfYzLlphQuS3HNC94CqlpNny3h7UdeUXcM0NOlhUBEuY5asVi60LnTAFCemlySXl0lQNKN/ly6oTVVe5auOFKl+wmRzJWETM71wg6908+n4M8BLzJcxoHWJ6m4KLXAS7WMbzsB+KyDQ/vp84hsvfhdgUj5NLt/WrVtdSY7CguNkV/P/ws7Fhi86qxu2V34e9/blZYTNqISTkwRriYYT0aCBB2vaN56pDcVzt+Wz41dXKymyheuTMPRUljFUfjIzgH5/vWSHpUEWDKTOwfjsCD6rv1`
 const testAccFingerprint = `45:95:56:9c:ef:e3:0f:63:66:21:b4:2c:b9:53:00:00`
 
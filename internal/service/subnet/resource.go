@@ -27,7 +27,11 @@
 	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
 )
 
-var _ resource.Resource = &subnetResource{}
+var (
+	_ resource.Resource               = &subnetResource{}
+	_ resource.ResourceWithConfigure  = &subnetResource{}
+	_ resource.ResourceWithModifyPlan = &subnetResource{}
+)
 
 func NewSubnetResource() resource.Resource {
 	return &subnetResource{}
@@ -49,6 +53,10 @@ func (r *subnetResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = apiclient
 }
 
+func (r *subnetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type subnetResourceModel struct {
 	subnetBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
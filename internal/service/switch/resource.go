@@ -31,6 +31,7 @@ type switchResource struct {
 	_ resource.Resource                = &switchResource{}
 	_ resource.ResourceWithConfigure   = &switchResource{}
 	_ resource.ResourceWithImportState = &switchResource{}
+	_ resource.ResourceWithModifyPlan  = &switchResource{}
 )
 
 func NewSwitchResource() resource.Resource {
@@ -49,6 +50,10 @@ func (r *switchResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.client = apiclient
 }
 
+func (r *switchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type switchResourceModel struct {
 	switchBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
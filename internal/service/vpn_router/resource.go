@@ -46,6 +46,7 @@ type vpnRouterResource struct {
 	_ resource.Resource                = &vpnRouterResource{}
 	_ resource.ResourceWithConfigure   = &vpnRouterResource{}
 	_ resource.ResourceWithImportState = &vpnRouterResource{}
+	_ resource.ResourceWithModifyPlan  = &vpnRouterResource{}
 )
 
 func NewVPNRouterResource() resource.Resource {
@@ -64,6 +65,10 @@ func (d *vpnRouterResource) Configure(ctx context.Context, req resource.Configur
 	d.client = apiclient
 }
 
+func (d *vpnRouterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, d.client, req.Plan, &resp.Diagnostics)
+}
+
 type vpnRouterResourceModel struct {
 	vpnRouterBaseModel
 	L2TP          *vpnRouterL2TPModel           `tfsdk:"l2tp"`
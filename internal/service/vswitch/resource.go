@@ -31,6 +31,7 @@ type vSwitchResource struct {
 	_ resource.Resource                = &vSwitchResource{}
 	_ resource.ResourceWithConfigure   = &vSwitchResource{}
 	_ resource.ResourceWithImportState = &vSwitchResource{}
+	_ resource.ResourceWithModifyPlan  = &vSwitchResource{}
 )
 
 func NewvSwitchResource() resource.Resource {
@@ -49,6 +50,10 @@ func (r *vSwitchResource) Configure(ctx context.Context, req resource.ConfigureR
 	r.client = apiclient
 }
 
+func (r *vSwitchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	common.ValidateAllowedZoneInPlan(ctx, r.client, req.Plan, &resp.Diagnostics)
+}
+
 type vSwitchResourceModel struct {
 	vSwitchBaseModel
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
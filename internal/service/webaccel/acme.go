@@ -0,0 +1,354 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"golang.org/x/crypto/acme"
+)
+
+const (
+	acmeLetsEncryptDirectoryURL = "https://acme-v02.api.letsencrypt.org/directory"
+	acmeChallengeLabel          = "_acme-challenge"
+	acmeChallengeRecordTTL      = 60
+	acmePropagationInterval     = 5 * time.Second
+
+	acmeKeyTypeRSA2048   = "rsa-2048"
+	acmeKeyTypeRSA4096   = "rsa-4096"
+	acmeKeyTypeECDSAP256 = "ecdsa-p256"
+	acmeKeyTypeECDSAP384 = "ecdsa-p384"
+)
+
+var acmeKeyTypes = []string{acmeKeyTypeRSA2048, acmeKeyTypeRSA4096, acmeKeyTypeECDSAP256, acmeKeyTypeECDSAP384}
+
+func newACMEClient(directoryURL, caCertificatePEM, accountKeyPEM string) (*acme.Client, error) {
+	key, err := parseACMEAccountKey(accountKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &acme.Client{
+		Key:          key,
+		DirectoryURL: directoryURL,
+		UserAgent:    "terraform-provider-sakura",
+	}
+	// Pebbleなどのプライベートな認証局を利用する場合のために、ACMEサーバーのCA証明書を追加で信頼する
+	if caCertificatePEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caCertificatePEM)) {
+			return nil, errors.New("failed to parse CA certificate of the ACME server")
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+		client.HTTPClient = &http.Client{Transport: transport}
+	}
+	return client, nil
+}
+
+func generateACMEAccountKey() (string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate ACME account key: %w", err)
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal ACME account key: %w", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), nil
+}
+
+func parseACMEAccountKey(keyPEM string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(keyPEM))
+	if block == nil {
+		return nil, errors.New("failed to decode ACME account key")
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ACME account key: %w", err)
+	}
+	return key, nil
+}
+
+// registerACMEAccount はACMEアカウントを登録し、アカウントのURLを返す。既に登録済みの鍵の場合は既存のアカウントを返す。
+func registerACMEAccount(ctx context.Context, client *acme.Client, email string) (string, error) {
+	account := &acme.Account{}
+	if email != "" {
+		account.Contact = []string{"mailto:" + email}
+	}
+
+	registered, err := client.Register(ctx, account, acme.AcceptTOS)
+	if errors.Is(err, acme.ErrAccountAlreadyExists) {
+		registered, err = client.GetReg(ctx, "")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to register ACME account: %w", err)
+	}
+	return registered.URI, nil
+}
+
+func updateACMEAccountContact(ctx context.Context, client *acme.Client, accountURL, email string) error {
+	account := &acme.Account{URI: accountURL, Contact: []string{}}
+	if email != "" {
+		account.Contact = []string{"mailto:" + email}
+	}
+	if _, err := client.UpdateReg(ctx, account); err != nil {
+		return fmt.Errorf("failed to update ACME account: %w", err)
+	}
+	return nil
+}
+
+type acmeDNS01Challenge struct {
+	authzURL  string
+	challenge *acme.Challenge
+	record    *iaas.DNSRecord
+	fqdn      string
+}
+
+// obtainACMECertificate はDNS-01チャレンジで証明書を発行し、PEM形式の証明書チェーンと秘密鍵を返す。
+// チャレンジ用のTXTレコードはdnsIDのゾーンに追加し、完了後に削除する。
+func obtainACMECertificate(ctx context.Context, client *acme.Client, caller iaas.APICaller, dnsID string, domains []string, keyType string, skipPropagationCheck bool) (string, string, error) {
+	dns, err := iaas.NewDNSOp(caller).Read(ctx, common.SakuraCloudID(dnsID))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read DNS[%s]: %w", dnsID, err)
+	}
+
+	order, err := client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create ACME order: %w", err)
+	}
+
+	var challenges []*acmeDNS01Challenge
+	for _, authzURL := range order.AuthzURLs {
+		authz, err := client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to get ACME authorization: %w", err)
+		}
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+
+		idx := slices.IndexFunc(authz.Challenges, func(c *acme.Challenge) bool { return c.Type == "dns-01" })
+		if idx < 0 {
+			return "", "", fmt.Errorf("ACME server does not offer dns-01 challenge for %q", authz.Identifier.Value)
+		}
+		chal := authz.Challenges[idx]
+		value, err := client.DNS01ChallengeRecord(chal.Token)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to compute dns-01 challenge record: %w", err)
+		}
+		name, err := acmeChallengeRecordName(dns.DNSZone, authz.Identifier.Value)
+		if err != nil {
+			return "", "", err
+		}
+		challenges = append(challenges, &acmeDNS01Challenge{
+			authzURL:  authzURL,
+			challenge: chal,
+			record: &iaas.DNSRecord{
+				Name:  name,
+				Type:  iaastypes.DNSRecordTypes.TXT,
+				RData: value,
+				TTL:   acmeChallengeRecordTTL,
+			},
+			fqdn: acmeChallengeLabel + "." + strings.TrimSuffix(authz.Identifier.Value, ".") + ".",
+		})
+	}
+
+	if len(challenges) > 0 {
+		records := make([]*iaas.DNSRecord, 0, len(challenges))
+		for _, c := range challenges {
+			records = append(records, c.record)
+		}
+		if err := updateACMEChallengeRecords(ctx, caller, dnsID, records, nil); err != nil {
+			return "", "", err
+		}
+		defer func() {
+			// 発行に失敗した場合やタイムアウトした場合でもTXTレコードを削除する
+			cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), common.Timeout5min)
+			defer cancel()
+			_ = updateACMEChallengeRecords(cleanupCtx, caller, dnsID, nil, records)
+		}()
+
+		if !skipPropagationCheck {
+			for _, c := range challenges {
+				if err := waitACMEChallengeRecordPropagation(ctx, dns.DNSNameServers, c.fqdn, c.record.RData); err != nil {
+					return "", "", err
+				}
+			}
+		}
+
+		for _, c := range challenges {
+			if _, err := client.Accept(ctx, c.challenge); err != nil {
+				return "", "", fmt.Errorf("failed to accept dns-01 challenge for %q: %w", c.fqdn, err)
+			}
+		}
+		for _, c := range challenges {
+			if _, err := client.WaitAuthorization(ctx, c.authzURL); err != nil {
+				return "", "", fmt.Errorf("failed to authorize %q: %w", c.fqdn, err)
+			}
+		}
+	}
+
+	order, err = client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to wait ACME order: %w", err)
+	}
+
+	key, keyPEM, err := generateACMECertificateKey(keyType)
+	if err != nil {
+		return "", "", err
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: domains[0]},
+		DNSNames: domains,
+	}, key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create certificate request: %w", err)
+	}
+	ders, _, err := client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to finalize ACME order: %w", err)
+	}
+
+	var chain strings.Builder
+	for _, der := range ders {
+		_ = pem.Encode(&chain, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+	return chain.String(), keyPEM, nil
+}
+
+func generateACMECertificateKey(keyType string) (crypto.Signer, string, error) {
+	var key crypto.Signer
+	var err error
+	switch keyType {
+	case acmeKeyTypeRSA2048:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case acmeKeyTypeRSA4096:
+		key, err = rsa.GenerateKey(rand.Reader, 4096)
+	case acmeKeyTypeECDSAP256:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case acmeKeyTypeECDSAP384:
+		key, err = ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	default:
+		return nil, "", fmt.Errorf("unsupported key type: %s", keyType)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to generate certificate key: %w", err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal certificate key: %w", err)
+	}
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
+
+// acmeChallengeRecordName はドメインのチャレンジ用TXTレコードの、ゾーン内での相対名を返す
+func acmeChallengeRecordName(zone, domain string) (string, error) {
+	zone = strings.ToLower(strings.TrimSuffix(zone, "."))
+	domain = strings.ToLower(strings.TrimSuffix(strings.TrimPrefix(domain, "*."), "."))
+
+	if domain == zone {
+		return acmeChallengeLabel, nil
+	}
+	if prefix, ok := strings.CutSuffix(domain, "."+zone); ok {
+		return acmeChallengeLabel + "." + prefix, nil
+	}
+	return "", fmt.Errorf("domain %q is not in the DNS zone %q", domain, zone)
+}
+
+// updateACMEChallengeRecords はゾーンにaddのレコードを追加し、removeのレコードを削除する
+func updateACMEChallengeRecords(ctx context.Context, caller iaas.APICaller, dnsID string, add, remove []*iaas.DNSRecord) error {
+	common.SakuraMutexKV.Lock(dnsID)
+	defer common.SakuraMutexKV.Unlock(dnsID)
+
+	dnsOp := iaas.NewDNSOp(caller)
+	dns, err := dnsOp.Read(ctx, common.SakuraCloudID(dnsID))
+	if err != nil {
+		return fmt.Errorf("failed to read DNS[%s]: %w", dnsID, err)
+	}
+
+	if _, err := dnsOp.UpdateSettings(ctx, dns.ID, &iaas.DNSUpdateSettingsRequest{
+		Records:      mergeACMEChallengeRecords(dns.Records, add, remove),
+		SettingsHash: dns.SettingsHash,
+	}); err != nil {
+		return fmt.Errorf("failed to update records for DNS[%s]: %w", dnsID, err)
+	}
+	return nil
+}
+
+func mergeACMEChallengeRecords(records, add, remove []*iaas.DNSRecord) []*iaas.DNSRecord {
+	isSame := func(r1, r2 *iaas.DNSRecord) bool {
+		return r1.Name == r2.Name && r1.Type == r2.Type && r1.RData == r2.RData
+	}
+
+	var merged []*iaas.DNSRecord
+	for _, r := range records {
+		if !slices.ContainsFunc(remove, func(x *iaas.DNSRecord) bool { return isSame(r, x) }) {
+			merged = append(merged, r)
+		}
+	}
+	for _, r := range add {
+		if !slices.ContainsFunc(merged, func(x *iaas.DNSRecord) bool { return isSame(r, x) }) {
+			merged = append(merged, r)
+		}
+	}
+	return merged
+}
+
+// waitACMEChallengeRecordPropagation は全ての権威DNSサーバーがTXTレコードを返すまで待つ
+func waitACMEChallengeRecordPropagation(ctx context.Context, nameServers []string, fqdn, value string) error {
+	for _, ns := range nameServers {
+		resolver := &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, net.JoinHostPort(strings.TrimSuffix(ns, "."), "53"))
+			},
+		}
+		for {
+			values, _ := resolver.LookupTXT(ctx, fqdn)
+			if slices.Contains(values, value) {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("timed out waiting for TXT record %q to propagate to %s: %w", fqdn, ns, ctx.Err())
+			case <-time.After(acmePropagationInterval):
+			}
+		}
+	}
+	return nil
+}
+
+// isWebAccelCertificateRenewalRequired は証明書の有効期限までの残り日数がminDaysRemaining未満かを返す
+func isWebAccelCertificateRenewalRequired(notAfter string, minDaysRemaining int64, now time.Time) bool {
+	t, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		return true
+	}
+	return now.Add(time.Duration(minDaysRemaining) * 24 * time.Hour).After(t)
+}
@@ -0,0 +1,100 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/sacloud/iaas-api-go"
+	iaastypes "github.com/sacloud/iaas-api-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestACMEChallengeRecordName(t *testing.T) {
+	cases := []struct {
+		zone   string
+		domain string
+		want   string
+	}{
+		{zone: "example.com", domain: "example.com", want: "_acme-challenge"},
+		{zone: "example.com", domain: "www.example.com", want: "_acme-challenge.www"},
+		{zone: "example.com.", domain: "WWW.Example.com.", want: "_acme-challenge.www"},
+		{zone: "example.com", domain: "*.cdn.example.com", want: "_acme-challenge.cdn"},
+		{zone: "example.com", domain: "*.example.com", want: "_acme-challenge"},
+	}
+	for _, tt := range cases {
+		got, err := acmeChallengeRecordName(tt.zone, tt.domain)
+		require.NoError(t, err, tt.domain)
+		require.Equal(t, tt.want, got, tt.domain)
+	}
+
+	_, err := acmeChallengeRecordName("example.com", "www.example.net")
+	require.ErrorContains(t, err, "is not in the DNS zone")
+	_, err = acmeChallengeRecordName("example.com", "badexample.com")
+	require.ErrorContains(t, err, "is not in the DNS zone")
+}
+
+func TestMergeACMEChallengeRecords(t *testing.T) {
+	a := &iaas.DNSRecord{Name: "www", Type: iaastypes.DNSRecordTypes.A, RData: "192.0.2.1", TTL: 3600}
+	txt1 := &iaas.DNSRecord{Name: "_acme-challenge", Type: iaastypes.DNSRecordTypes.TXT, RData: "token1", TTL: acmeChallengeRecordTTL}
+	txt2 := &iaas.DNSRecord{Name: "_acme-challenge", Type: iaastypes.DNSRecordTypes.TXT, RData: "token2", TTL: acmeChallengeRecordTTL}
+
+	added := mergeACMEChallengeRecords([]*iaas.DNSRecord{a, txt1}, []*iaas.DNSRecord{txt1, txt2}, nil)
+	require.Equal(t, []*iaas.DNSRecord{a, txt1, txt2}, added)
+
+	removed := mergeACMEChallengeRecords(added, nil, []*iaas.DNSRecord{txt1, txt2})
+	require.Equal(t, []*iaas.DNSRecord{a}, removed)
+}
+
+func TestIsWebAccelCertificateRenewalRequired(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.False(t, isWebAccelCertificateRenewalRequired("2026-03-01T00:00:00Z", 30, now))
+	require.True(t, isWebAccelCertificateRenewalRequired("2026-01-20T00:00:00Z", 30, now))
+	require.True(t, isWebAccelCertificateRenewalRequired("", 30, now))
+}
+
+func TestGenerateACMECertificateKey(t *testing.T) {
+	for _, keyType := range []string{acmeKeyTypeRSA2048, acmeKeyTypeECDSAP256, acmeKeyTypeECDSAP384} {
+		key, keyPEM, err := generateACMECertificateKey(keyType)
+		require.NoError(t, err, keyType)
+
+		block, _ := pem.Decode([]byte(keyPEM))
+		require.NotNil(t, block)
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		require.NoError(t, err)
+		require.Equal(t, key, parsed)
+
+		switch keyType {
+		case acmeKeyTypeRSA2048:
+			require.Equal(t, 2048, parsed.(*rsa.PrivateKey).N.BitLen())
+		case acmeKeyTypeECDSAP256:
+			require.Equal(t, "P-256", parsed.(*ecdsa.PrivateKey).Curve.Params().Name)
+		case acmeKeyTypeECDSAP384:
+			require.Equal(t, "P-384", parsed.(*ecdsa.PrivateKey).Curve.Params().Name)
+		}
+	}
+
+	_, _, err := generateACMECertificateKey("dsa")
+	require.ErrorContains(t, err, "unsupported key type")
+}
+
+func TestACMEAccountKey(t *testing.T) {
+	keyPEM, err := generateACMEAccountKey()
+	require.NoError(t, err)
+
+	key, err := parseACMEAccountKey(keyPEM)
+	require.NoError(t, err)
+	require.IsType(t, &ecdsa.PrivateKey{}, key)
+
+	_, err = parseACMEAccountKey("invalid")
+	require.ErrorContains(t, err, "failed to decode ACME account key")
+
+	_, err = newACMEClient(acmeLetsEncryptDirectoryURL, "invalid", keyPEM)
+	require.ErrorContains(t, err, "failed to parse CA certificate")
+}
@@ -0,0 +1,90 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+	"github.com/sacloud/webaccel-api-go"
+)
+
+type webAccelPurgeCacheAction struct {
+	client *webaccel.Client
+}
+
+var (
+	_ action.Action              = &webAccelPurgeCacheAction{}
+	_ action.ActionWithConfigure = &webAccelPurgeCacheAction{}
+)
+
+func NewWebAccelPurgeCacheAction() action.Action {
+	return &webAccelPurgeCacheAction{}
+}
+
+func (a *webAccelPurgeCacheAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webaccel_purge_cache"
+}
+
+func (a *webAccelPurgeCacheAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	a.client = apiclient.WebaccelClient
+}
+
+type webAccelPurgeCacheActionModel struct {
+	SiteID types.String `tfsdk:"site_id"`
+	URLs   types.List   `tfsdk:"urls"`
+}
+
+func (a *webAccelPurgeCacheAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"site_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The site ID of WebAccel.",
+			},
+			"urls": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The list of URLs to purge the cache. The URLs must belong to the domain of the site. If omitted, the whole cache of the site is purged.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(sacloudvalidator.StringFuncValidator(validateWebAccelCacheURL)),
+				},
+			},
+		},
+		MarkdownDescription: "Purges the cache of a WebAccel site. Requires Terraform 1.14 or later. Use `sakura_webaccel_cache_purge` with older versions.",
+	}
+}
+
+func (a *webAccelPurgeCacheAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data webAccelPurgeCacheActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := data.SiteID.ValueString()
+	urls := common.TlistToStrings(data.URLs)
+	if len(urls) == 0 {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("purging all cache of WebAccel site[%s]", siteID)})
+	} else {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("purging cache of %d URL(s) of WebAccel site[%s]", len(urls), siteID)})
+	}
+
+	if err := purgeWebAccelCache(ctx, a.client, siteID, urls); err != nil {
+		resp.Diagnostics.AddError("Invoke: API Error", err.Error())
+	}
+}
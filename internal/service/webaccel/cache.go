@@ -0,0 +1,81 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/sacloud/webaccel-api-go"
+)
+
+func validateWebAccelCacheURL(v string) error {
+	u, err := url.Parse(v)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("URL must be an absolute http or https URL: %q", v)
+	}
+	return nil
+}
+
+// purgeWebAccelCache はurlsが空の場合はサイト全体、それ以外は指定されたURLのキャッシュを削除する
+func purgeWebAccelCache(ctx context.Context, client *webaccel.Client, siteID string, urls []string) error {
+	op := webaccel.NewOp(client)
+	site, err := op.Read(ctx, siteID)
+	if err != nil {
+		return fmt.Errorf("failed to read WebAccel site[%s]: %w", siteID, err)
+	}
+	domains := webAccelSiteDomains(site)
+	if len(domains) == 0 {
+		return fmt.Errorf("WebAccel site[%s] has no domain", siteID)
+	}
+
+	if len(urls) == 0 {
+		if err := op.DeleteAllCache(ctx, &webaccel.DeleteAllCacheRequest{Domain: domains[0]}); err != nil {
+			return fmt.Errorf("failed to delete all cache of WebAccel site[%s]: %w", siteID, err)
+		}
+		return nil
+	}
+
+	// 他のサイトのキャッシュを誤って削除しないよう、サイトのドメイン以外のURLはエラーとする
+	for _, v := range urls {
+		u, err := url.Parse(v)
+		if err != nil {
+			return fmt.Errorf("invalid URL %q: %w", v, err)
+		}
+		if !slices.Contains(domains, strings.ToLower(u.Hostname())) {
+			return fmt.Errorf("URL %q does not belong to WebAccel site[%s]: expected one of %v", v, siteID, domains)
+		}
+	}
+
+	results, err := op.DeleteCache(ctx, &webaccel.DeleteCacheRequest{URL: urls})
+	if err != nil {
+		return fmt.Errorf("failed to delete cache of WebAccel site[%s]: %w", siteID, err)
+	}
+	var errs []error
+	for _, r := range results {
+		if r.Status < 200 || r.Status >= 300 {
+			errs = append(errs, fmt.Errorf("failed to delete cache of %q: status=%d, result=%s", r.URL, r.Status, r.Result))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// webAccelSiteDomains はサイトへのアクセスに利用できるドメインを返す。先頭はキャッシュの全削除に利用するドメイン。
+func webAccelSiteDomains(site *webaccel.Site) []string {
+	var domains []string
+	for _, d := range []string{site.Domain, site.ASCIIDomain, site.Subdomain} {
+		d = strings.ToLower(d)
+		if d != "" && !slices.Contains(domains, d) {
+			domains = append(domains, d)
+		}
+	}
+	return domains
+}
@@ -0,0 +1,31 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"testing"
+
+	"github.com/sacloud/webaccel-api-go"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWebAccelCacheURL(t *testing.T) {
+	require.NoError(t, validateWebAccelCacheURL("https://www.example.com/index.html"))
+	require.NoError(t, validateWebAccelCacheURL("http://www.example.com/"))
+
+	for _, v := range []string{"www.example.com/index.html", "/index.html", "ftp://www.example.com/", "https:///index.html", "%"} {
+		require.Error(t, validateWebAccelCacheURL(v), v)
+	}
+}
+
+func TestWebAccelSiteDomains(t *testing.T) {
+	require.Equal(t, []string{"www.example.com", "xxx.user.webaccel.jp"}, webAccelSiteDomains(&webaccel.Site{
+		Domain:      "www.example.com",
+		ASCIIDomain: "WWW.example.com",
+		Subdomain:   "xxx.user.webaccel.jp",
+	}))
+	require.Equal(t, []string{"xxx.user.webaccel.jp"}, webAccelSiteDomains(&webaccel.Site{
+		Subdomain: "xxx.user.webaccel.jp",
+	}))
+}
@@ -0,0 +1,122 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/webaccel-api-go"
+)
+
+type webAccelAccessLogsDataSource struct {
+	client *webaccel.Client
+}
+
+var (
+	_ datasource.DataSource              = &webAccelAccessLogsDataSource{}
+	_ datasource.DataSourceWithConfigure = &webAccelAccessLogsDataSource{}
+)
+
+func NewWebAccelAccessLogsDataSource() datasource.DataSource {
+	return &webAccelAccessLogsDataSource{}
+}
+
+func (d *webAccelAccessLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webaccel_access_logs"
+}
+
+func (d *webAccelAccessLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.client = apiclient.WebaccelClient
+}
+
+type webAccelAccessLogsDataSourceModel struct {
+	webAccelLoggingModel
+
+	ID          types.String `tfsdk:"id"`
+	SiteID      types.String `tfsdk:"site_id"`
+	AccessKeyID types.String `tfsdk:"access_key_id"`
+}
+
+func (d *webAccelAccessLogsDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get access log delivery settings of a Web Accelerator site",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the site",
+			},
+			"site_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the site",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the access log delivery is enabled or not",
+			},
+			"endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "Logging Object Storage's S3 endpoint",
+			},
+			"region": schema.StringAttribute{
+				Computed:    true,
+				Description: "Logging Object Storage's S3 region",
+			},
+			"bucket_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Logging Object Storage's bucket name",
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The access key ID used to upload logs to the bucket",
+			},
+		},
+	}
+}
+
+func (d *webAccelAccessLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webAccelAccessLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := data.SiteID.ValueString()
+	op := webaccel.NewOp(d.client)
+	if _, err := op.Read(ctx, siteID); err != nil {
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read WebAccel site[%s]: %s", siteID, err))
+		return
+	}
+
+	logCfg, err := op.ReadLogUploadConfig(ctx, siteID)
+	if err != nil {
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read logging config for WebAccel site[%s]: %s", siteID, err))
+		return
+	}
+
+	data.ID = types.StringValue(siteID)
+	// ログ設定が存在しない場合、APIは空のバケット名を返す
+	if logCfg == nil || logCfg.Bucket == "" {
+		data.webAccelLoggingModel = webAccelLoggingModel{
+			Enabled:    types.BoolValue(false),
+			Endpoint:   types.StringNull(),
+			Region:     types.StringNull(),
+			BucketName: types.StringNull(),
+		}
+		data.AccessKeyID = types.StringNull()
+	} else {
+		data.webAccelLoggingModel = *flattenWebAccelLogUploadConfigDataSource(logCfg)
+		data.AccessKeyID = types.StringValue(logCfg.AccessKeyID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,47 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDataSourceWebAccelAccessLogs_basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envWebAccelSiteName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSakuraDataSourceWebAccelAccessLogs_basic(os.Getenv(envWebAccelSiteName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.sakura_webaccel_access_logs.foobar", "id",
+						"data.sakura_webaccel.foobar", "id",
+					),
+					resource.TestMatchResourceAttr("data.sakura_webaccel_access_logs.foobar", "enabled", regexp.MustCompile("^(true|false)$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccSakuraDataSourceWebAccelAccessLogs_basic(siteName string) string {
+	tmpl := `
+data "sakura_webaccel" "foobar" {
+  name = "%s"
+}
+
+data "sakura_webaccel_access_logs" "foobar" {
+  site_id = data.sakura_webaccel.foobar.id
+}`
+	return fmt.Sprintf(tmpl, siteName)
+}
@@ -0,0 +1,231 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/webaccel-api-go"
+)
+
+type webAccelUsageDataSource struct {
+	client *webaccel.Client
+}
+
+var (
+	_ datasource.DataSource              = &webAccelUsageDataSource{}
+	_ datasource.DataSourceWithConfigure = &webAccelUsageDataSource{}
+)
+
+func NewWebAccelUsageDataSource() datasource.DataSource {
+	return &webAccelUsageDataSource{}
+}
+
+func (d *webAccelUsageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webaccel_usage"
+}
+
+func (d *webAccelUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	d.client = apiclient.WebaccelClient
+}
+
+type webAccelUsageDataSourceModel struct {
+	ID          types.String               `tfsdk:"id"`
+	TargetMonth types.String               `tfsdk:"target_month"`
+	SiteID      types.String               `tfsdk:"site_id"`
+	Year        types.Int64                `tfsdk:"year"`
+	Month       types.Int64                `tfsdk:"month"`
+	Sites       []webAccelSiteUsageModel   `tfsdk:"sites"`
+	Total       *webAccelUsageSummaryModel `tfsdk:"total"`
+}
+
+type webAccelSiteUsageModel struct {
+	SiteID             types.String  `tfsdk:"site_id"`
+	Domain             types.String  `tfsdk:"domain"`
+	ASCIIDomain        types.String  `tfsdk:"ascii_domain"`
+	Subdomain          types.String  `tfsdk:"subdomain"`
+	AccessCount        types.Int64   `tfsdk:"access_count"`
+	BytesSent          types.Int64   `tfsdk:"bytes_sent"`
+	CacheMissBytesSent types.Int64   `tfsdk:"cache_miss_bytes_sent"`
+	CacheHitRatio      types.Float64 `tfsdk:"cache_hit_ratio"`
+	BytesCacheHitRatio types.Float64 `tfsdk:"bytes_cache_hit_ratio"`
+	Price              types.Int64   `tfsdk:"price"`
+}
+
+type webAccelUsageSummaryModel struct {
+	AccessCount        types.Int64 `tfsdk:"access_count"`
+	BytesSent          types.Int64 `tfsdk:"bytes_sent"`
+	CacheMissBytesSent types.Int64 `tfsdk:"cache_miss_bytes_sent"`
+	Price              types.Int64 `tfsdk:"price"`
+}
+
+func (d *webAccelUsageDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get monthly usage of Web Accelerator sites",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The target month of the usage in yyyymm format",
+			},
+			"target_month": schema.StringAttribute{
+				Optional:    true,
+				Description: "The target month in yyyymm format (e.g. 202601). The current month is used if omitted",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}(0[1-9]|1[0-2])$`), "must be in yyyymm format"),
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the site to filter the usage. All sites are returned if omitted. Sites without usage in the month are not returned",
+			},
+			"year": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The year of the usage",
+			},
+			"month": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The month of the usage",
+			},
+			"sites": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Usage of each site",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"site_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the site",
+						},
+						"domain": schema.StringAttribute{
+							Computed:    true,
+							Description: "Domain name of the site",
+						},
+						"ascii_domain": schema.StringAttribute{
+							Computed:    true,
+							Description: "Domain name of the site in ASCII (punycode) form",
+						},
+						"subdomain": schema.StringAttribute{
+							Computed:    true,
+							Description: "Subdomain of the site",
+						},
+						"access_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of requests",
+						},
+						"bytes_sent": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The amount of data transferred in bytes",
+						},
+						"cache_miss_bytes_sent": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The amount of data transferred on cache misses in bytes",
+						},
+						"cache_hit_ratio": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The ratio of requests served from the cache",
+						},
+						"bytes_cache_hit_ratio": schema.Float64Attribute{
+							Computed:    true,
+							Description: "The ratio of bytes served from the cache",
+						},
+						"price": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The price of the usage in JPY",
+						},
+					},
+				},
+			},
+			"total": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "Total usage of the returned sites",
+				Attributes: map[string]schema.Attribute{
+					"access_count": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The total number of requests",
+					},
+					"bytes_sent": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The total amount of data transferred in bytes",
+					},
+					"cache_miss_bytes_sent": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The total amount of data transferred on cache misses in bytes",
+					},
+					"price": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The total price of the usage in JPY",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *webAccelUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webAccelUsageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op := webaccel.NewOp(d.client)
+	res, err := op.MonthlyUsage(ctx, data.TargetMonth.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read WebAccel monthly usage: %s", err))
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%04d%02d", res.Year, res.Month))
+	data.Year = types.Int64Value(int64(res.Year))
+	data.Month = types.Int64Value(int64(res.Month))
+	data.Sites, data.Total = flattenWebAccelMonthlyUsages(res.MonthlyUsages, data.SiteID.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// flattenWebAccelMonthlyUsages はサイト毎の使用量と、その合計を返す。siteIDが空でない場合は該当サイトのみを対象とする。
+// 対象月に利用のないサイトはAPIの結果に含まれないため、sitesが空になりうる
+func flattenWebAccelMonthlyUsages(usages []*webaccel.MonthlyUsage, siteID string) ([]webAccelSiteUsageModel, *webAccelUsageSummaryModel) {
+	sites := make([]webAccelSiteUsageModel, 0, len(usages))
+	var accessCount, bytesSent, cacheMissBytesSent, price int64
+	for _, u := range usages {
+		if u == nil || (siteID != "" && u.SiteID.String() != siteID) {
+			continue
+		}
+		sites = append(sites, webAccelSiteUsageModel{
+			SiteID:             types.StringValue(u.SiteID.String()),
+			Domain:             types.StringValue(u.Domain),
+			ASCIIDomain:        types.StringValue(u.ASCIIDomain),
+			Subdomain:          types.StringValue(u.Subdomain),
+			AccessCount:        types.Int64Value(u.AccessCount),
+			BytesSent:          types.Int64Value(u.BytesSent),
+			CacheMissBytesSent: types.Int64Value(u.CacheMissBytesSent),
+			CacheHitRatio:      types.Float64Value(u.CacheHitRatio),
+			BytesCacheHitRatio: types.Float64Value(u.BytesCacheHitRatio),
+			Price:              types.Int64Value(u.Price),
+		})
+		accessCount += u.AccessCount
+		bytesSent += u.BytesSent
+		cacheMissBytesSent += u.CacheMissBytesSent
+		price += u.Price
+	}
+
+	return sites, &webAccelUsageSummaryModel{
+		AccessCount:        types.Int64Value(accessCount),
+		BytesSent:          types.Int64Value(bytesSent),
+		CacheMissBytesSent: types.Int64Value(cacheMissBytesSent),
+		Price:              types.Int64Value(price),
+	}
+}
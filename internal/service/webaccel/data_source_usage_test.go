@@ -0,0 +1,61 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccSakuraDataSourceWebAccelUsage_basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envWebAccelSiteName)
+
+	regexpNotEmpty := regexp.MustCompile(".+")
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSakuraDataSourceWebAccelUsage_basic(os.Getenv(envWebAccelSiteName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.sakura_webaccel_usage.current", "id", regexp.MustCompile(`^\d{6}$`)),
+					resource.TestMatchResourceAttr("data.sakura_webaccel_usage.current", "year", regexpNotEmpty),
+					resource.TestMatchResourceAttr("data.sakura_webaccel_usage.current", "month", regexpNotEmpty),
+					resource.TestMatchResourceAttr("data.sakura_webaccel_usage.current", "total.price", regexpNotEmpty),
+					resource.TestCheckResourceAttr("data.sakura_webaccel_usage.previous", "id", "202601"),
+					resource.TestCheckResourceAttr("data.sakura_webaccel_usage.previous", "year", "2026"),
+					resource.TestCheckResourceAttr("data.sakura_webaccel_usage.previous", "month", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.sakura_webaccel_usage.site", "site_id",
+						"data.sakura_webaccel.site", "id",
+					),
+					resource.TestMatchResourceAttr("data.sakura_webaccel_usage.site", "total.access_count", regexpNotEmpty),
+				),
+			},
+		},
+	})
+}
+
+func testAccSakuraDataSourceWebAccelUsage_basic(siteName string) string {
+	tmpl := `
+data "sakura_webaccel" "site" {
+  name = "%s"
+}
+
+data "sakura_webaccel_usage" "current" {}
+
+data "sakura_webaccel_usage" "previous" {
+  target_month = "202601"
+}
+
+data "sakura_webaccel_usage" "site" {
+  site_id = data.sakura_webaccel.site.id
+}`
+	return fmt.Sprintf(tmpl, siteName)
+}
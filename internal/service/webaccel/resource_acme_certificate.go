@@ -0,0 +1,446 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/iaas-api-go"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	"github.com/sacloud/terraform-provider-sakura/internal/desc"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+	"github.com/sacloud/webaccel-api-go"
+)
+
+type webAccelACMECertificateResource struct {
+	client    *webaccel.Client
+	dnsClient iaas.APICaller
+}
+
+var (
+	_ resource.Resource               = &webAccelACMECertificateResource{}
+	_ resource.ResourceWithConfigure  = &webAccelACMECertificateResource{}
+	_ resource.ResourceWithModifyPlan = &webAccelACMECertificateResource{}
+)
+
+func NewWebAccelACMECertificateResource() resource.Resource {
+	return &webAccelACMECertificateResource{}
+}
+
+func (r *webAccelACMECertificateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webaccel_acme_certificate"
+}
+
+func (r *webAccelACMECertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.WebaccelClient
+	r.dnsClient = apiclient
+}
+
+type webAccelACMECertificateResourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	SiteID                  types.String   `tfsdk:"site_id"`
+	DNSID                   types.String   `tfsdk:"dns_id"`
+	Domains                 types.List     `tfsdk:"domains"`
+	Email                   types.String   `tfsdk:"email"`
+	ACMEServerURL           types.String   `tfsdk:"acme_server_url"`
+	ACMEServerCACertificate types.String   `tfsdk:"acme_server_ca_certificate"`
+	KeyType                 types.String   `tfsdk:"key_type"`
+	MinDaysRemaining        types.Int64    `tfsdk:"min_days_remaining"`
+	SkipPropagationCheck    types.Bool     `tfsdk:"skip_dns_propagation_check"`
+	AccountURL              types.String   `tfsdk:"account_url"`
+	AccountPrivateKeyPEM    types.String   `tfsdk:"account_private_key_pem"`
+	CertificatePEM          types.String   `tfsdk:"certificate_pem"`
+	SerialNumber            types.String   `tfsdk:"serial_number"`
+	NotBefore               types.String   `tfsdk:"not_before"`
+	NotAfter                types.String   `tfsdk:"not_after"`
+	IssuerCommonName        types.String   `tfsdk:"issuer_common_name"`
+	SHA256Fingerprint       types.String   `tfsdk:"sha256_fingerprint"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *webAccelACMECertificateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("WebAccel ACME Certificate"),
+			"site_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The site ID of WebAccel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dns_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the DNS zone (`sakura_dns`) to write the TXT records for the dns-01 challenges. All of the `domains` must be in this zone.",
+				Validators: []validator.String{
+					sacloudvalidator.SakuraIDValidator(),
+				},
+			},
+			"domains": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: "The domain names of the certificate. The first one is used as the common name. Wildcard domains (e.g. `*.example.com`) are also supported. Default is the domain of the site.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Optional:    true,
+				Description: "The email address registered as the contact of the ACME account.",
+			},
+			"acme_server_url": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(acmeLetsEncryptDirectoryURL),
+				Description: desc.Sprintf("The directory URL of the ACME server. Default is `%s` (Let's Encrypt).", acmeLetsEncryptDirectoryURL),
+			},
+			"acme_server_ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "The CA certificate in PEM format to trust the TLS certificate of the ACME server, in addition to the system certificates. e.g. the root certificate of Pebble.",
+			},
+			"key_type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(acmeKeyTypeRSA2048),
+				Description: desc.Sprintf("The type of the private key of the certificate. This must be one of [%s].", acmeKeyTypes),
+				Validators: []validator.String{
+					stringvalidator.OneOf(acmeKeyTypes...),
+				},
+			},
+			"min_days_remaining": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Description: "The certificate is renewed when the number of days until the expiration is less than this value at plan time.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"skip_dns_propagation_check": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to skip waiting for the TXT records to be served by all of the name servers of the DNS zone.",
+			},
+			"account_url": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the ACME account.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_private_key_pem": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key of the ACME account in PEM format. This is generated by the provider.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				Computed:    true,
+				Description: "The issued certificate chain in PEM format. The private key of the certificate is uploaded to the site and not stored in the state.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"serial_number": schema.StringAttribute{
+				Computed:    true,
+				Description: "Certificate serial number.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_before": schema.StringAttribute{
+				Computed:    true,
+				Description: "Certificate validity start time (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_after": schema.StringAttribute{
+				Computed:    true,
+				Description: "Certificate validity end time (RFC3339).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issuer_common_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Issuer common name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha256_fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA256 fingerprint of the certificate.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true, Update: true, Delete: true,
+			}),
+		},
+		MarkdownDescription: "Manages a WebAccel certificate issued and renewed by an ACME server (e.g. Let's Encrypt) with dns-01 challenges.\n\n" +
+			"The TXT records for the challenges are written into the `sakura_dns` zone specified by `dns_id` and removed after the issuance. " +
+			"The certificate is renewed by `terraform apply` when the remaining days are less than `min_days_remaining`, so run apply periodically. " +
+			"Do not use this resource together with `sakura_webaccel_certificate` for the same site.",
+	}
+}
+
+// ModifyPlan は証明書の再発行が必要かを判定し、必要な場合は証明書の属性をUnknownにしてUpdateで再発行させる
+func (r *webAccelACMECertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state webAccelACMECertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newAccount := !plan.ACMEServerURL.Equal(state.ACMEServerURL)
+	reissue := newAccount ||
+		!plan.Domains.Equal(state.Domains) ||
+		!plan.KeyType.Equal(state.KeyType) ||
+		isWebAccelCertificateRenewalRequired(state.NotAfter.ValueString(), plan.MinDaysRemaining.ValueInt64(), time.Now())
+
+	if newAccount {
+		plan.AccountURL = types.StringUnknown()
+		plan.AccountPrivateKeyPEM = types.StringUnknown()
+	}
+	if reissue {
+		plan.CertificatePEM = types.StringUnknown()
+		plan.SerialNumber = types.StringUnknown()
+		plan.NotBefore = types.StringUnknown()
+		plan.NotAfter = types.StringUnknown()
+		plan.IssuerCommonName = types.StringUnknown()
+		plan.SHA256Fingerprint = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *webAccelACMECertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webAccelACMECertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutCreate(ctx, plan.Timeouts, common.Timeout20min)
+	defer cancel()
+
+	siteID := plan.SiteID.ValueString()
+	op := webaccel.NewOp(r.client)
+	if plan.Domains.IsUnknown() {
+		site, err := op.Read(ctx, siteID)
+		if err != nil {
+			resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to read WebAccel site[%s]: %s", siteID, err))
+			return
+		}
+		plan.Domains = common.StringsToTlist([]string{site.Domain})
+	}
+
+	if err := r.setupAccount(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Create: ACME Error", err.Error())
+		return
+	}
+	res, err := r.issue(ctx, &plan, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Create: API Error", fmt.Sprintf("failed to issue WebAccel ACME certificate[%s]: %s", siteID, err))
+		return
+	}
+
+	plan.updateState(res)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *webAccelACMECertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webAccelACMECertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteID := state.SiteID.ValueString()
+	certs, err := webaccel.NewOp(r.client).ReadCertificate(ctx, siteID)
+	if err != nil {
+		if webaccel.IsNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read: API Error", fmt.Sprintf("failed to read WebAccel certificate[%s]: %s", siteID, err))
+		return
+	}
+	if certs.Current == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.updateState(certs.Current)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *webAccelACMECertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state webAccelACMECertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutUpdate(ctx, plan.Timeouts, common.Timeout20min)
+	defer cancel()
+
+	siteID := plan.SiteID.ValueString()
+	if plan.AccountURL.IsUnknown() {
+		if err := r.setupAccount(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Update: ACME Error", err.Error())
+			return
+		}
+	} else if !plan.Email.Equal(state.Email) {
+		client, err := newACMEClient(plan.ACMEServerURL.ValueString(), plan.ACMEServerCACertificate.ValueString(), plan.AccountPrivateKeyPEM.ValueString())
+		if err == nil {
+			err = updateACMEAccountContact(ctx, client, plan.AccountURL.ValueString(), plan.Email.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Update: ACME Error", err.Error())
+			return
+		}
+	}
+
+	if plan.SerialNumber.IsUnknown() {
+		res, err := r.issue(ctx, &plan, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Update: API Error", fmt.Sprintf("failed to renew WebAccel ACME certificate[%s]: %s", siteID, err))
+			return
+		}
+		plan.updateState(res)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *webAccelACMECertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webAccelACMECertificateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := common.SetupTimeoutDelete(ctx, state.Timeouts, common.Timeout5min)
+	defer cancel()
+
+	siteID := state.SiteID.ValueString()
+	if err := webaccel.NewOp(r.client).DeleteCertificate(ctx, siteID); err != nil {
+		if webaccel.IsNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Delete: API Error", fmt.Sprintf("failed to delete WebAccel certificate[%s]: %s", siteID, err))
+		return
+	}
+}
+
+// setupAccount はACMEアカウントの鍵を生成して登録する
+func (r *webAccelACMECertificateResource) setupAccount(ctx context.Context, model *webAccelACMECertificateResourceModel) error {
+	key, err := generateACMEAccountKey()
+	if err != nil {
+		return err
+	}
+	client, err := newACMEClient(model.ACMEServerURL.ValueString(), model.ACMEServerCACertificate.ValueString(), key)
+	if err != nil {
+		return err
+	}
+	accountURL, err := registerACMEAccount(ctx, client, model.Email.ValueString())
+	if err != nil {
+		return err
+	}
+
+	model.AccountURL = types.StringValue(accountURL)
+	model.AccountPrivateKeyPEM = types.StringValue(key)
+	return nil
+}
+
+// issue は証明書を発行してサイトにアップロードする
+func (r *webAccelACMECertificateResource) issue(ctx context.Context, model *webAccelACMECertificateResourceModel, create bool) (*webaccel.CurrentCertificate, error) {
+	client, err := newACMEClient(model.ACMEServerURL.ValueString(), model.ACMEServerCACertificate.ValueString(), model.AccountPrivateKeyPEM.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	chain, key, err := obtainACMECertificate(ctx, client, r.dnsClient, model.DNSID.ValueString(), common.TlistToStrings(model.Domains),
+		model.KeyType.ValueString(), model.SkipPropagationCheck.ValueBool())
+	if err != nil {
+		return nil, err
+	}
+
+	siteID := model.SiteID.ValueString()
+	op := webaccel.NewOp(r.client)
+	param := &webaccel.CreateOrUpdateCertificateRequest{CertificateChain: chain, Key: key}
+	// 既に証明書が登録されているサイトでは更新する
+	if create {
+		certs, err := op.ReadCertificate(ctx, siteID)
+		if err != nil && !webaccel.IsNotFoundError(err) {
+			return nil, err
+		}
+		create = certs == nil || certs.Current == nil
+	}
+
+	var res *webaccel.Certificates
+	if create {
+		res, err = op.CreateCertificate(ctx, siteID, param)
+	} else {
+		res, err = op.UpdateCertificate(ctx, siteID, param)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	model.ID = types.StringValue(siteID)
+	model.CertificatePEM = types.StringValue(chain)
+	return res.Current, nil
+}
+
+func (m *webAccelACMECertificateResourceModel) updateState(data *webaccel.CurrentCertificate) {
+	if data == nil {
+		return
+	}
+
+	m.ID = types.StringValue(data.SiteID)
+	m.SiteID = types.StringValue(data.SiteID)
+	m.SerialNumber = types.StringValue(data.SerialNumber)
+	m.NotBefore = types.StringValue(time.Unix(data.NotBefore/1000, 0).Format(time.RFC3339))
+	m.NotAfter = types.StringValue(time.Unix(data.NotAfter/1000, 0).Format(time.RFC3339))
+	m.IssuerCommonName = types.StringValue(data.Issuer.CommonName)
+	m.SHA256Fingerprint = types.StringValue(data.SHA256Fingerprint)
+}
@@ -0,0 +1,112 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+	"github.com/sacloud/webaccel-api-go"
+)
+
+// Pebble(https://github.com/letsencrypt/pebble)などのテスト用ACMEサーバーに対して実行する。
+// Pebbleの-dnsserverにはDNSゾーンのネームサーバーを指定すること。
+const (
+	envWebAccelACMEDNSID        = "SAKURA_WEBACCEL_ACME_DNS_ID"
+	envWebAccelACMEServerURL    = "SAKURA_WEBACCEL_ACME_SERVER_URL"
+	envWebAccelACMEServerCAPath = "SAKURA_WEBACCEL_ACME_SERVER_CA_PATH"
+)
+
+func TestAccResourceSakuraWebAccelACMECertificate_Basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envWebAccelSiteName, envWebAccelACMEDNSID, envWebAccelACMEServerURL, envWebAccelACMEServerCAPath)
+
+	siteName := os.Getenv(envWebAccelSiteName)
+	dnsID := os.Getenv(envWebAccelACMEDNSID)
+	serverURL := os.Getenv(envWebAccelACMEServerURL)
+	caPath := os.Getenv(envWebAccelACMEServerCAPath)
+	resourceName := "sakura_webaccel_acme_certificate.foobar"
+	regexpNotEmpty := regexp.MustCompile(".+")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckSakuraWebAccelACMECertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraWebAccelACMECertificate_basic, siteName, dnsID, serverURL, caPath, "30"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "site_id", "data.sakura_webaccel.site", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "domains.0", "data.sakura_webaccel.site", "domain"),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "rsa-2048"),
+					resource.TestMatchResourceAttr(resourceName, "account_url", regexpNotEmpty),
+					resource.TestMatchResourceAttr(resourceName, "certificate_pem", regexp.MustCompile("^-----BEGIN CERTIFICATE-----")),
+					resource.TestMatchResourceAttr(resourceName, "serial_number", regexpNotEmpty),
+					resource.TestMatchResourceAttr(resourceName, "not_after", regexpNotEmpty),
+					resource.TestMatchResourceAttr(resourceName, "sha256_fingerprint", regexpNotEmpty),
+				),
+			},
+			{
+				// 有効期限までの日数より大きい値を指定すると、planで再発行が計画される
+				Config:             test.BuildConfigWithArgs(testAccSakuraWebAccelACMECertificate_basic, siteName, dnsID, serverURL, caPath, "3650"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// 再発行後も有効期限までの日数はmin_days_remainingより小さいため、apply後のplanでも再発行が計画される
+				Config: test.BuildConfigWithArgs(testAccSakuraWebAccelACMECertificate_basic, siteName, dnsID, serverURL, caPath, "3650"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "serial_number", regexpNotEmpty),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// min_days_remainingを戻すと再発行は計画されない
+				Config: test.BuildConfigWithArgs(testAccSakuraWebAccelACMECertificate_basic, siteName, dnsID, serverURL, caPath, "30"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "serial_number", regexpNotEmpty),
+				),
+			},
+		},
+	})
+}
+
+func testCheckSakuraWebAccelACMECertificateDestroy(s *terraform.State) error {
+	client := test.AccClientGetter()
+	op := webaccel.NewOp(client.WebaccelClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sakura_webaccel_acme_certificate" {
+			continue
+		}
+		if rs.Primary.ID == "" {
+			continue
+		}
+
+		res, err := op.ReadCertificate(context.Background(), rs.Primary.ID)
+		if err == nil && res.Current != nil {
+			return fmt.Errorf("still exists WebAccel ACME Certificate: %s", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+var testAccSakuraWebAccelACMECertificate_basic = `
+data "sakura_webaccel" "site" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_webaccel_acme_certificate" "foobar" {
+  site_id                    = data.sakura_webaccel.site.id
+  dns_id                     = "{{ .arg1 }}"
+  acme_server_url            = "{{ .arg2 }}"
+  acme_server_ca_certificate = file("{{ .arg3 }}")
+  min_days_remaining         = {{ .arg4 }}
+}
+`
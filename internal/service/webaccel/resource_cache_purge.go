@@ -0,0 +1,135 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sacloud/terraform-provider-sakura/internal/common"
+	sacloudvalidator "github.com/sacloud/terraform-provider-sakura/internal/validator"
+	"github.com/sacloud/webaccel-api-go"
+)
+
+type webAccelCachePurgeResource struct {
+	client *webaccel.Client
+}
+
+var (
+	_ resource.Resource              = &webAccelCachePurgeResource{}
+	_ resource.ResourceWithConfigure = &webAccelCachePurgeResource{}
+)
+
+func NewWebAccelCachePurgeResource() resource.Resource {
+	return &webAccelCachePurgeResource{}
+}
+
+func (r *webAccelCachePurgeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webaccel_cache_purge"
+}
+
+func (r *webAccelCachePurgeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	apiclient := common.GetApiClientFromProvider(req.ProviderData, &resp.Diagnostics)
+	if apiclient == nil {
+		return
+	}
+	r.client = apiclient.WebaccelClient
+}
+
+type webAccelCachePurgeResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	SiteID   types.String `tfsdk:"site_id"`
+	URLs     types.List   `tfsdk:"urls"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	PurgedAt types.String `tfsdk:"purged_at"`
+}
+
+func (r *webAccelCachePurgeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": common.SchemaResourceId("WebAccel Cache Purge"),
+			"site_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The site ID of WebAccel.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"urls": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The list of URLs to purge the cache. The URLs must belong to the domain of the site. If omitted, the whole cache of the site is purged.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(sacloudvalidator.StringFuncValidator(validateWebAccelCacheURL)),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "A map of arbitrary strings that, when changed, will purge the cache again. e.g. the etag of the deployed objects.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"purged_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The time when the cache was purged.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		MarkdownDescription: "Purges the cache of a WebAccel site when the resource is created or any of the arguments are changed.\n\n" +
+			"Destroying this resource does nothing. With Terraform 1.14 or later, consider the `sakura_webaccel_purge_cache` action instead.",
+	}
+}
+
+func (r *webAccelCachePurgeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webAccelCachePurgeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := purgeWebAccelCache(ctx, r.client, plan.SiteID.ValueString(), common.TlistToStrings(plan.URLs)); err != nil {
+		resp.Diagnostics.AddError("Create: API Error", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	plan.PurgedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *webAccelCachePurgeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// キャッシュの削除は一度きりの操作のため、APIから読み込む状態はない
+}
+
+func (r *webAccelCachePurgeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// 全ての引数がRequiresReplaceのため、Updateは呼ばれない
+	var plan webAccelCachePurgeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *webAccelCachePurgeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
@@ -0,0 +1,164 @@
+// Copyright 2016-2026 The terraform-provider-sakura Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package webaccel_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/sacloud/terraform-provider-sakura/internal/test"
+)
+
+func TestAccResourceSakuraWebAccelCachePurge_Basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envWebAccelSiteName)
+
+	siteName := os.Getenv(envWebAccelSiteName)
+	resourceName := "sakura_webaccel_cache_purge.foobar"
+
+	var purgeID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraWebAccelCachePurge_all, siteName, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "purged_at"),
+					resource.TestCheckNoResourceAttr(resourceName, "urls"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "v1"),
+					func(s *terraform.State) error {
+						purgeID = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// triggersが変わった場合は再度キャッシュを削除する
+				Config: test.BuildConfigWithArgs(testAccSakuraWebAccelCachePurge_all, siteName, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "v2"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[resourceName].Primary.ID; id == purgeID {
+							return fmt.Errorf("cache purge is not replaced: %s", id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraWebAccelCachePurge_urls, siteName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "urls.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSakuraWebAccelCachePurge_InvalidURL(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envWebAccelSiteName)
+
+	siteName := os.Getenv(envWebAccelSiteName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      test.BuildConfigWithArgs(testAccSakuraWebAccelCachePurge_otherDomain, siteName),
+				ExpectError: regexp.MustCompile("does not belong to WebAccel site"),
+			},
+		},
+	})
+}
+
+func TestAccSakuraWebAccelPurgeCacheAction_Basic(t *testing.T) {
+	test.SkipIfEnvIsNotSet(t, envWebAccelSiteName)
+
+	siteName := os.Getenv(envWebAccelSiteName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraWebAccelPurgeCacheAction_basic, siteName, "v1"),
+			},
+			{
+				Config: test.BuildConfigWithArgs(testAccSakuraWebAccelPurgeCacheAction_basic, siteName, "v2"),
+			},
+		},
+	})
+}
+
+var testAccSakuraWebAccelCachePurge_all = `
+data "sakura_webaccel" "site" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_webaccel_cache_purge" "foobar" {
+  site_id = data.sakura_webaccel.site.id
+  triggers = {
+    version = "{{ .arg1 }}"
+  }
+}
+`
+
+var testAccSakuraWebAccelCachePurge_urls = `
+data "sakura_webaccel" "site" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_webaccel_cache_purge" "foobar" {
+  site_id = data.sakura_webaccel.site.id
+  urls = [
+    "https://${data.sakura_webaccel.site.domain}/index.html",
+    "https://${data.sakura_webaccel.site.domain}/assets/app.js",
+  ]
+}
+`
+
+var testAccSakuraWebAccelCachePurge_otherDomain = `
+data "sakura_webaccel" "site" {
+  name = "{{ .arg0 }}"
+}
+
+resource "sakura_webaccel_cache_purge" "foobar" {
+  site_id = data.sakura_webaccel.site.id
+  urls    = ["https://example.com/index.html"]
+}
+`
+
+var testAccSakuraWebAccelPurgeCacheAction_basic = `
+data "sakura_webaccel" "site" {
+  name = "{{ .arg0 }}"
+}
+
+action "sakura_webaccel_purge_cache" "foobar" {
+  config {
+    site_id = data.sakura_webaccel.site.id
+    urls    = ["https://${data.sakura_webaccel.site.domain}/index.html"]
+  }
+}
+
+resource "terraform_data" "deploy" {
+  input = "{{ .arg1 }}"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.sakura_webaccel_purge_cache.foobar]
+    }
+  }
+}
+`
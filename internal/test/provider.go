@@ -10,7 +10,6 @@
 	"unsafe"
 
 	"github.com/hashicorp/terraform-plugin-framework/provider"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/sacloud/terraform-provider-sakura/internal/common"
 	sakura "github.com/sacloud/terraform-provider-sakura/internal/provider"
@@ -32,7 +31,7 @@ func init() {
 	// APIClientをテストで利用するためのAccProvider
 	AccProvider = sakura.New("test")()
 	AccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-		"sakura": providerserver.NewProtocol6WithError(AccProvider),
+		"sakura": sakura.NewProtocol6ServerWithError(AccProvider),
 	}
 	AccClientGetter = func() *common.APIClient {
 		var v = reflect.ValueOf(AccProvider).Elem()
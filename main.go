@@ -4,11 +4,11 @@
 package main
 
 import (
-	"context"
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	sakura "github.com/sacloud/terraform-provider-sakura/internal/provider"
 	ver "github.com/sacloud/terraform-provider-sakura/version"
 )
@@ -19,11 +19,18 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "registry.terraform.io/sacloud/sakura",
-		Debug:   debug,
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
 	}
-	err := providerserver.Serve(context.Background(), sakura.New(ver.Version), opts)
+	// read_onlyモードのエラーにリソースと操作名を含めるため、providerserver.Serveではなく独自のサーバーを利用する
+	err := tf6server.Serve("registry.terraform.io/sacloud/sakura", func() tfprotov6.ProviderServer {
+		server, err := sakura.NewProtocol6ServerWithError(sakura.New(ver.Version)())()
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		return server
+	}, opts...)
 
 	if err != nil {
 		log.Fatal(err.Error())
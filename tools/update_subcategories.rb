@@ -9,17 +9,13 @@ orig_str = "subcategory: \"\""
 subcategories.each do |category, files|
   files.each do |file|
     sub = "subcategory: \"#{category}\""
-    ds = File.join(docs_dir, "data-sources", "#{file}.md")
-    rs = File.join(docs_dir, "resources", "#{file}.md")
-    if File.exist?(ds)
-      content = File.read(ds)
-      new_content = content.gsub(orig_str, sub)
-      File.write(ds, new_content)
-    end
-    if File.exist?(rs)
-      content = File.read(rs)
-      new_content = content.gsub(orig_str, sub)
-      File.write(rs, new_content)
+    ["data-sources", "resources", "ephemeral-resources", "actions"].each do |dir|
+      doc = File.join(docs_dir, dir, "#{file}.md")
+      if File.exist?(doc)
+        content = File.read(doc)
+        new_content = content.gsub(orig_str, sub)
+        File.write(doc, new_content)
+      end
     end
   end
 end